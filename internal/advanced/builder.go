@@ -0,0 +1,56 @@
+package advanced
+
+import "slices"
+
+// Why interviewers ask this:
+// The builder pattern separates "describe how to configure" from "produce the value".
+// With generics, one Builder[T] works for every struct, and because its steps are plain
+// func(*T) it interoperates directly with functional options (type Option func(*Server)).
+
+// Common pitfalls:
+// - Writing a hand-rolled builder per type with one method per field
+// - Applying mutations immediately, so the builder can't be reused for several values
+// - Letting Build return a pointer that aliases state shared with the builder
+// - Appending to a shared steps slice, so two builders branched from one base overwrite
+//   each other's steps (append may write into the same backing array)
+// - Confusing "zero value base" with "defaults"; use BuildFrom to start from defaults
+
+// Key takeaway:
+// Store mutations as a list of func(*T) and apply them in order when Build is called.
+// Since T is copied into Build's local variable, every Build returns an independent value.
+// With returns a new builder instead of mutating the receiver, so the same builder can be
+// reused or extended later, even along several branches.
+
+// Builder accumulates mutation steps and applies them when Build is called
+type Builder[T any] struct {
+	steps []func(*T)
+}
+
+// NewBuilder creates an empty builder for T
+func NewBuilder[T any]() *Builder[T] {
+	return &Builder[T]{
+		steps: make([]func(*T), 0),
+	}
+}
+
+// With returns a new builder with step added after b's steps; b itself is unchanged
+// Steps run in the order they were added, so later steps override earlier ones
+func (b *Builder[T]) With(step func(*T)) *Builder[T] {
+	// Clip drops spare capacity so append always copies instead of writing into b's array
+	return &Builder[T]{steps: append(slices.Clip(b.steps), step)}
+}
+
+// Build applies all steps to the zero value of T
+func (b *Builder[T]) Build() T {
+	var zero T
+	return b.BuildFrom(zero)
+}
+
+// BuildFrom applies all steps to a copy of base, leaving base unchanged
+func (b *Builder[T]) BuildFrom(base T) T {
+	result := base
+	for _, step := range b.steps {
+		step(&result)
+	}
+	return result
+}
@@ -0,0 +1,95 @@
+package advanced
+
+import "testing"
+
+type builderConfig struct {
+	Host    string
+	Port    int
+	Debug   bool
+	Retries int
+}
+
+func TestBuilder_ChainedWith(t *testing.T) {
+	cfg := NewBuilder[builderConfig]().
+		With(func(c *builderConfig) { c.Host = "api.example.com" }).
+		With(func(c *builderConfig) { c.Port = 443 }).
+		With(func(c *builderConfig) { c.Debug = true }).
+		With(func(c *builderConfig) { c.Retries = 3 }).
+		Build()
+
+	expected := builderConfig{Host: "api.example.com", Port: 443, Debug: true, Retries: 3}
+	if cfg != expected {
+		t.Errorf("expected %+v, got %+v", expected, cfg)
+	}
+}
+
+func TestBuilder_WithFunctionalOptions(t *testing.T) {
+	// Server options are func(*Server), so they plug straight into a Builder
+	server := NewBuilder[Server]().
+		With(WithHost("example.com")).
+		With(WithPort(9000)).
+		With(WithServerTimeout(5)).
+		With(WithMaxConnections(50)).
+		With(WithTLS(true)).
+		Build()
+
+	if server.host != "example.com" || server.port != 9000 || server.timeout != 5 ||
+		server.maxConn != 50 || !server.tls {
+		t.Errorf("not all fields were set: %+v", server)
+	}
+}
+
+func TestBuilder_BuildFromBase(t *testing.T) {
+	defaults := builderConfig{Host: "localhost", Port: 8080, Retries: 1}
+
+	cfg := NewBuilder[builderConfig]().
+		With(func(c *builderConfig) { c.Port = 9090 }).
+		BuildFrom(defaults)
+
+	if cfg.Host != "localhost" || cfg.Port != 9090 || cfg.Retries != 1 {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+	if defaults.Port != 8080 {
+		t.Error("BuildFrom must not modify the base value")
+	}
+}
+
+func TestBuilder_LaterStepsOverride(t *testing.T) {
+	cfg := NewBuilder[builderConfig]().
+		With(func(c *builderConfig) { c.Port = 1 }).
+		With(func(c *builderConfig) { c.Port = 2 }).
+		Build()
+
+	if cfg.Port != 2 {
+		t.Errorf("expected last step to win, got %d", cfg.Port)
+	}
+}
+
+func TestBuilder_Reusable(t *testing.T) {
+	b := NewBuilder[builderConfig]().With(func(c *builderConfig) { c.Retries++ })
+
+	first := b.Build()
+	second := b.Build()
+
+	// Each Build starts fresh, so steps don't accumulate across builds
+	if first.Retries != 1 || second.Retries != 1 {
+		t.Errorf("expected independent builds, got %d and %d", first.Retries, second.Retries)
+	}
+}
+
+func TestBuilder_BranchFromSharedBase(t *testing.T) {
+	base := NewBuilder[builderConfig]().With(func(c *builderConfig) { c.Host = "db.internal" })
+
+	primary := base.With(func(c *builderConfig) { c.Port = 5432 })
+	replica := base.With(func(c *builderConfig) { c.Port = 5433 })
+
+	if cfg := primary.Build(); cfg.Host != "db.internal" || cfg.Port != 5432 {
+		t.Errorf("primary: unexpected config %+v", cfg)
+	}
+	if cfg := replica.Build(); cfg.Host != "db.internal" || cfg.Port != 5433 {
+		t.Errorf("replica: unexpected config %+v", cfg)
+	}
+	if cfg := base.Build(); cfg.Port != 0 {
+		t.Errorf("branching must not change the base, got port %d", cfg.Port)
+	}
+}
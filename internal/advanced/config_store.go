@@ -0,0 +1,66 @@
+package advanced
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Why interviewers ask this:
+// Services reload config (feature flags, rate limits, routing tables) without restarting,
+// while every request reads it. A RWMutex works but makes the hot read path pay for a
+// rare write. atomic.Value lets readers load a pointer with no locking at all - a classic
+// "read-mostly data" question.
+
+// Common pitfalls:
+// - Mutating the current config in place, so readers see half-updated fields (torn reads)
+// - Replacing the value before validating it, so a bad file takes the service down
+// - Storing values of different concrete types in one atomic.Value (it panics)
+// - Two concurrent reloads finishing out of order, leaving the older config active
+
+// Key takeaway:
+// Treat each config as immutable: build a complete new value, then atomically swap the
+// pointer. Readers either see the old snapshot or the new one, never a mix. Keep the old
+// value when loading fails, and serialize writers so reloads apply in order.
+
+// configSnapshot wraps each value so atomic.Value always stores the same concrete type,
+// even when T is an interface
+type configSnapshot[T any] struct {
+	value T
+}
+
+// ConfigStore holds a hot-reloadable value; Get never blocks
+type ConfigStore[T any] struct {
+	current  atomic.Value // *configSnapshot[T]
+	reloadMu sync.Mutex   // Serializes Reload; readers never touch it
+	loader   func() (T, error)
+}
+
+// NewConfigStore creates a store and performs the initial load
+func NewConfigStore[T any](loader func() (T, error)) (*ConfigStore[T], error) {
+	s := &ConfigStore[T]{loader: loader}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Get returns the current value without locking
+// Callers must treat the value as read-only, since other readers share it
+func (s *ConfigStore[T]) Get() T {
+	return s.current.Load().(*configSnapshot[T]).value
+}
+
+// Reload loads a fresh value and atomically swaps it in
+// If the loader fails, the previous value stays in place and the error is returned
+func (s *ConfigStore[T]) Reload() error {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	value, err := s.loader()
+	if err != nil {
+		return err
+	}
+
+	s.current.Store(&configSnapshot[T]{value: value})
+	return nil
+}
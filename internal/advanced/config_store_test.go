@@ -0,0 +1,123 @@
+package advanced
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+type appConfig struct {
+	Version  int
+	Limits   []int // Every element equals Version in a consistent snapshot
+	Checksum int   // Always Version * 31
+}
+
+func versionedLoader() func() (appConfig, error) {
+	version := 0
+	return func() (appConfig, error) {
+		version++
+		limits := make([]int, 8)
+		for i := range limits {
+			limits[i] = version
+		}
+		return appConfig{Version: version, Limits: limits, Checksum: version * 31}, nil
+	}
+}
+
+func TestConfigStore_InitialLoadAndReload(t *testing.T) {
+	store, err := NewConfigStore(versionedLoader())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if v := store.Get().Version; v != 1 {
+		t.Errorf("expected version 1 after initial load, got %d", v)
+	}
+
+	store.Reload()
+	if v := store.Get().Version; v != 2 {
+		t.Errorf("expected version 2 after reload, got %d", v)
+	}
+}
+
+func TestConfigStore_FailedReloadKeepsPreviousValue(t *testing.T) {
+	fail := false
+	store, _ := NewConfigStore(func() (string, error) {
+		if fail {
+			return "", errors.New("invalid config")
+		}
+		return "v1", nil
+	})
+
+	fail = true
+	if err := store.Reload(); err == nil {
+		t.Error("expected reload error")
+	}
+	if store.Get() != "v1" {
+		t.Errorf("expected previous value v1, got %q", store.Get())
+	}
+}
+
+func TestConfigStore_InitialLoadError(t *testing.T) {
+	store, err := NewConfigStore(func() (int, error) {
+		return 0, errors.New("missing file")
+	})
+
+	if err == nil || store != nil {
+		t.Errorf("expected nil store and error, got %v, %v", store, err)
+	}
+}
+
+func TestConfigStore_ReadersNeverSeeTornValues(t *testing.T) {
+	store, _ := NewConfigStore(versionedLoader())
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(done)
+		for i := 0; i < 500; i++ {
+			store.Reload()
+		}
+	}()
+
+	for r := 0; r < 8; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lastVersion := 0
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				cfg := store.Get()
+				if cfg.Checksum != cfg.Version*31 {
+					t.Errorf("torn read: version %d with checksum %d", cfg.Version, cfg.Checksum)
+					return
+				}
+				for _, limit := range cfg.Limits {
+					if limit != cfg.Version {
+						t.Errorf("torn read: version %d with limits %v", cfg.Version, cfg.Limits)
+						return
+					}
+				}
+				if cfg.Version < lastVersion {
+					t.Errorf("version went backwards: %d after %d", cfg.Version, lastVersion)
+					return
+				}
+				lastVersion = cfg.Version
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if v := store.Get().Version; v != 501 {
+		t.Errorf("expected final version 501, got %d", v)
+	}
+}
@@ -83,6 +83,21 @@ func GetUserID(ctx context.Context) string {
 	return ""
 }
 
+const requestIDKey contextKey = "requestID"
+
+// WithRequestID returns a child context carrying the request ID
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// GetRequestID returns the request ID stored in ctx, or "" if there is none
+func GetRequestID(ctx context.Context) string {
+	if requestID, ok := ctx.Value(requestIDKey).(string); ok {
+		return requestID
+	}
+	return ""
+}
+
 // ChainedContext demonstrates context propagation
 func ChainedContext(ctx context.Context) error {
 	// Create child context with timeout
@@ -0,0 +1,53 @@
+package advanced
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Why interviewers ask this:
+// Request-scoped logging is where context values and cancellation meet in real services:
+// every line should carry the request ID, and work for a request the client already
+// abandoned should stop - including noisy logging. It checks that you know what belongs
+// in context (request metadata) and what doesn't (the logger itself, config, dependencies).
+
+// Common pitfalls:
+// - Storing the logger or the request ID in a struct instead of reading it from ctx per call
+// - Using a plain string as the context key (collides across packages)
+// - Writing from many goroutines to a shared io.Writer without synchronization
+// - Checking ctx.Err() only at the start of a handler, not before each side effect
+
+// Key takeaway:
+// Pass ctx to Log like any other call; the logger pulls request-scoped values out of it
+// with typed-key helpers. A cancelled ctx means nobody is waiting on this request, so
+// skip the write. The logger holds only the output, never a context.
+
+// ContextLogger writes log lines prefixed with the request ID found in the context
+type ContextLogger struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// NewContextLogger creates a logger writing to out
+func NewContextLogger(out io.Writer) *ContextLogger {
+	return &ContextLogger{out: out}
+}
+
+// Log writes msg prefixed with ctx's request ID
+// Returns without writing if ctx is already cancelled or past its deadline
+func (l *ContextLogger) Log(ctx context.Context, msg string) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	requestID := GetRequestID(ctx)
+	if requestID == "" {
+		requestID = "-"
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fmt.Fprintf(l.out, "[request_id=%s] %s\n", requestID, msg)
+}
@@ -0,0 +1,80 @@
+package advanced
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestContextLogger_PrefixesRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewContextLogger(&buf)
+
+	ctx := WithRequestID(context.Background(), "req-42")
+	logger.Log(ctx, "user loaded")
+
+	expected := "[request_id=req-42] user loaded\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestContextLogger_MissingRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewContextLogger(&buf)
+
+	logger.Log(context.Background(), "startup")
+
+	expected := "[request_id=-] startup\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestContextLogger_CancelledContextSuppressesLog(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewContextLogger(&buf)
+
+	ctx, cancel := context.WithCancel(WithRequestID(context.Background(), "req-1"))
+	logger.Log(ctx, "before cancel")
+	cancel()
+	logger.Log(ctx, "after cancel")
+
+	expected := "[request_id=req-1] before cancel\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestContextLogger_ConcurrentWritesStayWhole(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewContextLogger(&buf)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logger.Log(WithRequestID(context.Background(), "req"), "msg")
+		}()
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 50 {
+		t.Fatalf("expected 50 lines, got %d", len(lines))
+	}
+	for _, line := range lines {
+		if line != "[request_id=req] msg" {
+			t.Errorf("unexpected line %q", line)
+		}
+	}
+}
+
+func TestGetRequestID_NotFound(t *testing.T) {
+	if id := GetRequestID(context.Background()); id != "" {
+		t.Errorf("expected empty string, got %s", id)
+	}
+}
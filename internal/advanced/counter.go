@@ -0,0 +1,100 @@
+package advanced
+
+import "container/heap"
+
+// Why interviewers ask this:
+// "Find the k most frequent elements" is one of the most common interview questions,
+// and a generic Counter shows you can combine maps, heaps, and type parameters into a
+// reusable utility (Python's collections.Counter is the usual reference point).
+
+// Common pitfalls:
+// - Sorting every distinct element (O(d log d)) when only the top n are needed
+// - Using a max-heap of all elements instead of a size-n min-heap
+// - Non-deterministic output for ties because map iteration order is random
+// - Requiring an ordered constraint when comparable is all that counting needs
+
+// Key takeaway:
+// Count with a map in O(len(items)). For the top n keep a min-heap of size n: push each
+// candidate and pop the smallest once the heap exceeds n, giving O(d log n) overall.
+// Break ties by first occurrence so results are stable across runs.
+
+// Counter returns how many times each element appears in items
+// Time Complexity: O(n)
+// Space Complexity: O(d) where d is the number of distinct elements
+func Counter[T comparable](items []T) map[T]int {
+	counts := make(map[T]int)
+	for _, item := range items {
+		counts[item]++
+	}
+	return counts
+}
+
+// MostCommon returns the n most frequent elements with their counts, ordered from
+// most to least frequent. Ties are broken by first occurrence in items.
+// Time Complexity: O(len(items) + d log n)
+// Space Complexity: O(d)
+func MostCommon[T comparable](items []T, n int) []Pair[T, int] {
+	if n <= 0 {
+		return []Pair[T, int]{}
+	}
+
+	counts := make(map[T]int)
+	firstSeen := make(map[T]int)
+	order := make([]T, 0)
+	for i, item := range items {
+		if _, seen := firstSeen[item]; !seen {
+			firstSeen[item] = i
+			order = append(order, item)
+		}
+		counts[item]++
+	}
+
+	// Keep the n "best" entries in a min-heap; the root is the weakest candidate
+	h := &counterHeap[T]{}
+	for _, item := range order {
+		heap.Push(h, counterEntry[T]{value: item, count: counts[item], first: firstSeen[item]})
+		if h.Len() > n {
+			heap.Pop(h)
+		}
+	}
+
+	// Popping yields weakest first, so fill the result from the back
+	result := make([]Pair[T, int], h.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		entry := heap.Pop(h).(counterEntry[T])
+		result[i] = NewPair(entry.value, entry.count)
+	}
+
+	return result
+}
+
+// counterEntry is a heap element tracking an element's count and first index
+type counterEntry[T any] struct {
+	value T
+	count int
+	first int
+}
+
+// counterHeap is a min-heap ordered by count, then by later first occurrence
+// so that earlier elements win ties and stay in the heap
+type counterHeap[T any] []counterEntry[T]
+
+func (h counterHeap[T]) Len() int { return len(h) }
+
+func (h counterHeap[T]) Less(i, j int) bool {
+	if h[i].count != h[j].count {
+		return h[i].count < h[j].count
+	}
+	return h[i].first > h[j].first
+}
+
+func (h counterHeap[T]) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *counterHeap[T]) Push(x any) { *h = append(*h, x.(counterEntry[T])) }
+
+func (h *counterHeap[T]) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
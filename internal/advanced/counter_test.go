@@ -0,0 +1,67 @@
+package advanced
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	counts := Counter([]string{"a", "b", "a", "c", "b", "a"})
+
+	expected := map[string]int{"a": 3, "b": 2, "c": 1}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("expected %v, got %v", expected, counts)
+	}
+}
+
+func TestCounter_Empty(t *testing.T) {
+	counts := Counter([]int{})
+	if len(counts) != 0 {
+		t.Errorf("expected empty map, got %v", counts)
+	}
+}
+
+func TestMostCommon(t *testing.T) {
+	items := []int{1, 1, 1, 2, 2, 3, 4, 4, 4, 4}
+
+	result := MostCommon(items, 2)
+
+	expected := []Pair[int, int]{{Key: 4, Value: 4}, {Key: 1, Value: 3}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestMostCommon_TiesBrokenByFirstOccurrence(t *testing.T) {
+	// b, a and c all appear twice; b is seen first, then a, then c
+	items := []string{"b", "a", "c", "c", "a", "b", "d"}
+
+	result := MostCommon(items, 2)
+
+	expected := []Pair[string, int]{{Key: "b", Value: 2}, {Key: "a", Value: 2}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	// Repeated calls must return the same answer despite random map order
+	for i := 0; i < 10; i++ {
+		if !reflect.DeepEqual(MostCommon(items, 2), expected) {
+			t.Fatal("MostCommon is not deterministic")
+		}
+	}
+}
+
+func TestMostCommon_NLargerThanDistinct(t *testing.T) {
+	result := MostCommon([]string{"x", "y", "x"}, 10)
+
+	expected := []Pair[string, int]{{Key: "x", Value: 2}, {Key: "y", Value: 1}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestMostCommon_ZeroN(t *testing.T) {
+	if len(MostCommon([]int{1, 2, 3}, 0)) != 0 {
+		t.Error("expected empty result for n=0")
+	}
+}
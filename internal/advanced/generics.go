@@ -145,6 +145,43 @@ func Values[K comparable, V any](m map[K]V) []V {
 	return values
 }
 
+// SliceToMap builds a map by projecting each element to a key-value pair
+// If several elements produce the same key, the last one wins
+func SliceToMap[T any, K comparable, V any](s []T, fn func(T) (K, V)) map[K]V {
+	result := make(map[K]V, len(s))
+	for _, item := range s {
+		k, v := fn(item)
+		result[k] = v
+	}
+	return result
+}
+
+// AssociateBy indexes elements by a key derived from each element
+// If several elements produce the same key, the last one wins
+func AssociateBy[T any, K comparable](s []T, keyFn func(T) K) map[K]T {
+	return SliceToMap(s, func(item T) (K, T) {
+		return keyFn(item), item
+	})
+}
+
+// AssociateWith maps each element to a value derived from it
+// Duplicate elements collapse into a single key
+func AssociateWith[K comparable, V any](s []K, valueFn func(K) V) map[K]V {
+	return SliceToMap(s, func(item K) (K, V) {
+		return item, valueFn(item)
+	})
+}
+
+// MapToSlice projects every map entry into a slice element
+// Order follows map iteration and is therefore not deterministic
+func MapToSlice[K comparable, V any, R any](m map[K]V, fn func(K, V) R) []R {
+	result := make([]R, 0, len(m))
+	for k, v := range m {
+		result = append(result, fn(k, v))
+	}
+	return result
+}
+
 // Pair represents a key-value pair
 type Pair[K, V any] struct {
 	Key   K
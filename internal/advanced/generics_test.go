@@ -2,6 +2,7 @@ package advanced
 
 import (
 	"reflect"
+	"sort"
 	"testing"
 )
 
@@ -269,3 +270,68 @@ func TestAverage_Empty(t *testing.T) {
 		t.Errorf("expected 0, got %f", avg)
 	}
 }
+
+type genericsUser struct {
+	ID   int
+	Name string
+}
+
+func TestSliceToMap(t *testing.T) {
+	users := []genericsUser{{1, "alice"}, {2, "bob"}}
+
+	byID := SliceToMap(users, func(u genericsUser) (int, string) {
+		return u.ID, u.Name
+	})
+
+	expected := map[int]string{1: "alice", 2: "bob"}
+	if !reflect.DeepEqual(byID, expected) {
+		t.Errorf("expected %v, got %v", expected, byID)
+	}
+}
+
+func TestSliceToMap_DuplicateKeysLastWins(t *testing.T) {
+	users := []genericsUser{{1, "alice"}, {1, "alicia"}}
+
+	byID := SliceToMap(users, func(u genericsUser) (int, string) {
+		return u.ID, u.Name
+	})
+
+	if len(byID) != 1 || byID[1] != "alicia" {
+		t.Errorf("expected last value to win, got %v", byID)
+	}
+}
+
+func TestAssociateBy(t *testing.T) {
+	users := []genericsUser{{1, "alice"}, {2, "bob"}}
+
+	byName := AssociateBy(users, func(u genericsUser) string { return u.Name })
+
+	if byName["bob"].ID != 2 || byName["alice"].ID != 1 {
+		t.Errorf("unexpected index: %v", byName)
+	}
+}
+
+func TestAssociateWith(t *testing.T) {
+	lengths := AssociateWith([]string{"go", "rust", "go"}, func(s string) int { return len(s) })
+
+	expected := map[string]int{"go": 2, "rust": 4}
+	if !reflect.DeepEqual(lengths, expected) {
+		t.Errorf("expected %v, got %v", expected, lengths)
+	}
+}
+
+func TestMapToSlice(t *testing.T) {
+	m := map[int]string{1: "alice", 2: "bob"}
+
+	users := MapToSlice(m, func(id int, name string) genericsUser {
+		return genericsUser{ID: id, Name: name}
+	})
+
+	// Map iteration order is random, so sort before comparing
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+
+	expected := []genericsUser{{1, "alice"}, {2, "bob"}}
+	if !reflect.DeepEqual(users, expected) {
+		t.Errorf("expected %v, got %v", expected, users)
+	}
+}
@@ -0,0 +1,94 @@
+package advanced
+
+// Why interviewers ask this:
+// Persistent (immutable) data structures come up when discussing functional programming,
+// undo history, snapshots, and lock-free sharing between goroutines. A cons-list is the
+// simplest example of structural sharing: new versions reuse the old ones instead of
+// copying them.
+
+// Common pitfalls:
+// - Copying the whole list on every push (O(n)) instead of sharing the tail
+// - Exposing node pointers so callers can mutate "immutable" data
+// - Assuming "immutable" means "must copy"; sharing is safe precisely because nothing changes
+// - Forgetting that only the head is cheap; appending at the end is still O(n)
+
+// Key takeaway:
+// Push creates one new node pointing at the existing list, so it is O(1) and the old list
+// is untouched. Many versions can share the same tail, and because nodes are never
+// modified they can be read concurrently without locks.
+
+// persistentNode is an immutable cons cell
+type persistentNode[T any] struct {
+	value  T
+	next   *persistentNode[T]
+	length int
+}
+
+// PersistentList is an immutable singly linked list with structural sharing
+// The zero value is an empty list. Every "modifying" method returns a new list.
+// Time Complexity: Push O(1), Head O(1), Tail O(1), Length O(1), ToSlice O(n)
+type PersistentList[T any] struct {
+	node *persistentNode[T]
+}
+
+// NewPersistentList builds a list whose Head is values[0]
+func NewPersistentList[T any](values ...T) PersistentList[T] {
+	var list PersistentList[T]
+	for i := len(values) - 1; i >= 0; i-- {
+		list = list.Push(values[i])
+	}
+	return list
+}
+
+// Push returns a new list with value in front; the receiver is unchanged
+// and becomes the new list's tail (shared, not copied)
+func (l PersistentList[T]) Push(value T) PersistentList[T] {
+	return PersistentList[T]{
+		node: &persistentNode[T]{
+			value:  value,
+			next:   l.node,
+			length: l.Length() + 1,
+		},
+	}
+}
+
+// Head returns the first element
+// Returns zero value and false if the list is empty
+func (l PersistentList[T]) Head() (T, bool) {
+	if l.node == nil {
+		var zero T
+		return zero, false
+	}
+	return l.node.value, true
+}
+
+// Tail returns the list without its first element
+// The tail of an empty list is an empty list
+func (l PersistentList[T]) Tail() PersistentList[T] {
+	if l.node == nil {
+		return l
+	}
+	return PersistentList[T]{node: l.node.next}
+}
+
+// Length returns the number of elements
+func (l PersistentList[T]) Length() int {
+	if l.node == nil {
+		return 0
+	}
+	return l.node.length
+}
+
+// IsEmpty returns true if the list has no elements
+func (l PersistentList[T]) IsEmpty() bool {
+	return l.node == nil
+}
+
+// ToSlice returns the elements from head to end
+func (l PersistentList[T]) ToSlice() []T {
+	result := make([]T, 0, l.Length())
+	for n := l.node; n != nil; n = n.next {
+		result = append(result, n.value)
+	}
+	return result
+}
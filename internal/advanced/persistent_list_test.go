@@ -0,0 +1,79 @@
+package advanced
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPersistentList_PushLeavesOriginalIntact(t *testing.T) {
+	original := NewPersistentList(2, 3)
+
+	extended := original.Push(1)
+
+	if !reflect.DeepEqual(original.ToSlice(), []int{2, 3}) {
+		t.Errorf("original changed: %v", original.ToSlice())
+	}
+	if !reflect.DeepEqual(extended.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", extended.ToSlice())
+	}
+	if original.Length() != 2 || extended.Length() != 3 {
+		t.Errorf("unexpected lengths: %d, %d", original.Length(), extended.Length())
+	}
+}
+
+func TestPersistentList_StructuralSharing(t *testing.T) {
+	base := NewPersistentList("b", "c")
+
+	// Two different versions derived from the same base
+	withA := base.Push("a")
+	withZ := base.Push("z")
+
+	// Both tails are the very same list, not copies
+	if withA.Tail() != base || withZ.Tail() != base {
+		t.Error("derived lists should share the base as their tail")
+	}
+	if withA.Tail() != withZ.Tail() {
+		t.Error("sibling lists should share the same tail")
+	}
+
+	if !reflect.DeepEqual(withA.ToSlice(), []string{"a", "b", "c"}) {
+		t.Errorf("unexpected withA: %v", withA.ToSlice())
+	}
+	if !reflect.DeepEqual(withZ.ToSlice(), []string{"z", "b", "c"}) {
+		t.Errorf("unexpected withZ: %v", withZ.ToSlice())
+	}
+}
+
+func TestPersistentList_HeadAndTail(t *testing.T) {
+	list := NewPersistentList(1, 2, 3)
+
+	head, ok := list.Head()
+	if !ok || head != 1 {
+		t.Errorf("expected head 1, got %d", head)
+	}
+
+	tail := list.Tail()
+	if !reflect.DeepEqual(tail.ToSlice(), []int{2, 3}) {
+		t.Errorf("expected tail [2 3], got %v", tail.ToSlice())
+	}
+	if !reflect.DeepEqual(list.ToSlice(), []int{1, 2, 3}) {
+		t.Error("Tail should not modify the list")
+	}
+}
+
+func TestPersistentList_Empty(t *testing.T) {
+	var list PersistentList[int]
+
+	if !list.IsEmpty() || list.Length() != 0 {
+		t.Error("zero value should be an empty list")
+	}
+	if _, ok := list.Head(); ok {
+		t.Error("Head of empty list should return false")
+	}
+	if !list.Tail().IsEmpty() {
+		t.Error("Tail of empty list should be empty")
+	}
+	if len(list.ToSlice()) != 0 {
+		t.Error("ToSlice of empty list should be empty")
+	}
+}
@@ -0,0 +1,103 @@
+package algo
+
+// Why interviewers ask this:
+// "Is b an anagram of a?" is the warm-up; the follow-ups are "what about Unicode?" and
+// "what if the text arrives one character at a time?". They test whether you can move
+// from sorting to counting, and from recounting each window to updating it incrementally.
+
+// Common pitfalls:
+// - Indexing bytes instead of runes, so "é" is compared as two unrelated bytes
+// - Forgetting "é" can be one rune (U+00E9) or two ("e" + U+0301); normalize before counting
+// - Sorting both strings: O(n log n) when a count map gives O(n)
+// - Re-comparing whole count maps on every streamed character instead of tracking a mismatch count
+
+// Key takeaway:
+// Count runes up for one string and down for the other; all zeros means anagram.
+// For a stream, keep a fixed window of the last len(pattern) runes and a counter of how
+// many distinct runes still differ from the pattern - each push updates it in O(1).
+
+// IsAnagram reports whether b is a rearrangement of the runes in a
+// Time Complexity: O(n)
+// Space Complexity: O(k) where k is the number of distinct runes
+func IsAnagram(a, b string) bool {
+	counts := make(map[rune]int)
+	for _, r := range a {
+		counts[r]++
+	}
+
+	for _, r := range b {
+		counts[r]--
+		if counts[r] < 0 {
+			return false
+		}
+	}
+
+	for _, c := range counts {
+		if c != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// StreamingAnagramWindow checks, one rune at a time, whether the most recent
+// runes of a stream form an anagram of a fixed pattern
+type StreamingAnagramWindow struct {
+	diff       map[rune]int // window count minus pattern count, per rune
+	mismatched int          // number of runes whose diff is non-zero
+	window     []rune       // ring buffer of the last len(window) runes
+	next       int          // ring buffer slot to overwrite next
+	seen       int          // total runes pushed, capped at len(window)
+}
+
+// NewStreamingAnagramWindow creates a window matching anagrams of pattern
+func NewStreamingAnagramWindow(pattern string) *StreamingAnagramWindow {
+	w := &StreamingAnagramWindow{diff: make(map[rune]int)}
+
+	for _, r := range pattern {
+		w.window = append(w.window, r)
+		w.adjust(r, -1)
+	}
+
+	return w
+}
+
+// Push adds r to the stream and reports whether the last len(pattern) runes
+// are an anagram of the pattern
+// Time Complexity: O(1) per rune
+// Space Complexity: O(m) for the window, where m is the pattern length in runes
+func (w *StreamingAnagramWindow) Push(r rune) bool {
+	if len(w.window) == 0 {
+		return true
+	}
+
+	if w.seen == len(w.window) {
+		// Window is full: the oldest rune slides out
+		w.adjust(w.window[w.next], -1)
+	} else {
+		w.seen++
+	}
+
+	w.window[w.next] = r
+	w.next = (w.next + 1) % len(w.window)
+	w.adjust(r, 1)
+
+	return w.seen == len(w.window) && w.mismatched == 0
+}
+
+// adjust changes r's diff by delta and keeps the mismatch count in sync
+func (w *StreamingAnagramWindow) adjust(r rune, delta int) {
+	before := w.diff[r]
+	after := before + delta
+
+	if before == 0 {
+		w.mismatched++
+	}
+	if after == 0 {
+		w.mismatched--
+		delete(w.diff, r)
+	} else {
+		w.diff[r] = after
+	}
+}
@@ -0,0 +1,60 @@
+package algo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsAnagram(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"anagram", "nagaram", true},
+		{"rat", "car", false},
+		{"ab", "abc", false},
+		{"aab", "abb", false},
+		{"", "", true},
+		{"héllo", "olléh", true},
+		{"日本語", "語日本", true},
+		{"\u00e9", "e\u0301", false}, // precomposed vs combining sequence are different runes
+	}
+
+	for _, tt := range tests {
+		result := IsAnagram(tt.a, tt.b)
+		if result != tt.expected {
+			t.Errorf("IsAnagram(%q, %q): expected %v, got %v", tt.a, tt.b, tt.expected, result)
+		}
+	}
+}
+
+func TestStreamingAnagramWindow_MatchesFindAnagrams(t *testing.T) {
+	s, p := "cbaebabacd", "abc"
+
+	w := NewStreamingAnagramWindow(p)
+	result := []int{}
+	for i, r := range s {
+		if w.Push(r) {
+			result = append(result, i-len(p)+1)
+		}
+	}
+
+	expected := FindAnagrams(s, p)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestStreamingAnagramWindow_Unicode(t *testing.T) {
+	w := NewStreamingAnagramWindow("ñé")
+
+	var result []bool
+	for _, r := range "aéñx" {
+		result = append(result, w.Push(r))
+	}
+
+	expected := []bool{false, false, true, false}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
@@ -0,0 +1,61 @@
+package algo
+
+import "math"
+
+// Why interviewers ask this:
+// String-to-integer (atoi) looks trivial but is packed with edge cases: whitespace,
+// signs, trailing garbage, and overflow. Interviewers use it to see whether you
+// clarify requirements up front and handle boundaries without relying on a library.
+
+// Common pitfalls:
+// - Detecting overflow only after it has already happened (wrapping the accumulator)
+// - Accepting a sign that is not immediately followed by a digit
+// - Skipping whitespace in the middle of the number instead of only at the start
+// - Returning an error instead of clamping when the value is out of range
+
+// Key takeaway:
+// Parse in phases: whitespace -> optional sign -> digits. Check for overflow before
+// each multiply-and-add step by comparing against (limit - digit) / 10, and clamp
+// to the int32 range instead of wrapping.
+
+// Atoi converts a string to a 32-bit signed integer using the LeetCode "atoi" rules.
+// Leading whitespace is skipped, an optional '+' or '-' is read, and digits are
+// consumed until the first non-digit. Out-of-range values clamp to int32 min/max.
+// Returns 0 if no digits are found.
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func Atoi(s string) int {
+	i := 0
+
+	// Phase 1: skip leading whitespace
+	for i < len(s) && s[i] == ' ' {
+		i++
+	}
+
+	// Phase 2: optional sign
+	sign := 1
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		if s[i] == '-' {
+			sign = -1
+		}
+		i++
+	}
+
+	// Phase 3: digits, checking overflow before it happens
+	result := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		digit := int(s[i] - '0')
+
+		if result > (math.MaxInt32-digit)/10 {
+			if sign == 1 {
+				return math.MaxInt32
+			}
+			return math.MinInt32
+		}
+
+		result = result*10 + digit
+		i++
+	}
+
+	return sign * result
+}
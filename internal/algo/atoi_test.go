@@ -0,0 +1,54 @@
+package algo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAtoi(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected int
+	}{
+		{"42", 42},
+		{"   -42", -42},
+		{"4193 with words", 4193},
+		{"words and 987", 0},
+		{"+1", 1},
+		{"+-12", 0},
+		{"00000123", 123},
+		{"", 0},
+		{"   ", 0},
+		{"-", 0},
+		{"12 34", 12},
+	}
+
+	for _, tt := range tests {
+		result := Atoi(tt.s)
+		if result != tt.expected {
+			t.Errorf("Atoi(%q): expected %d, got %d", tt.s, tt.expected, result)
+		}
+	}
+}
+
+func TestAtoi_Overflow(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected int
+	}{
+		{"2147483647", math.MaxInt32},
+		{"2147483648", math.MaxInt32},
+		{"91283472332", math.MaxInt32},
+		{"-2147483648", math.MinInt32},
+		{"-2147483649", math.MinInt32},
+		{"-91283472332", math.MinInt32},
+		{"99999999999999999999999", math.MaxInt32},
+	}
+
+	for _, tt := range tests {
+		result := Atoi(tt.s)
+		if result != tt.expected {
+			t.Errorf("Atoi(%q): expected %d, got %d", tt.s, tt.expected, result)
+		}
+	}
+}
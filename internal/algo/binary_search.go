@@ -16,6 +16,7 @@ package algo
 // Binary search reduces search space by half each iteration: O(log n) time.
 // Always use left + (right - left) / 2 to avoid overflow.
 // Works only on sorted arrays.
+// LowerBound/UpperBound (first >= target, first > target) bracket every copy of target.
 
 // BinarySearch finds target in sorted array, returns index or -1
 // Time Complexity: O(log n)
@@ -39,6 +40,59 @@ func BinarySearch(arr []int, target int) int {
 	return -1 // Not found
 }
 
+// BinarySearchFunc finds target in an array sorted by cmp, returns index or -1
+// cmp returns negative, zero or positive like strings.Compare; with duplicates the first
+// match is returned
+// Time Complexity: O(log n)
+// Space Complexity: O(1)
+func BinarySearchFunc[T any](arr []T, target T, cmp func(a, b T) int) int {
+	i := LowerBound(arr, target, cmp)
+	if i < len(arr) && cmp(arr[i], target) == 0 {
+		return i
+	}
+	return -1 // Not found
+}
+
+// LowerBound returns the first index whose element is >= target, or len(arr) if none is
+// Time Complexity: O(log n)
+// Space Complexity: O(1)
+func LowerBound[T any](arr []T, target T, cmp func(a, b T) int) int {
+	// Half-open range [left, right): the answer is always inside it, and may be len(arr)
+	left, right := 0, len(arr)
+
+	for left < right {
+		mid := left + (right-left)/2
+
+		if cmp(arr[mid], target) < 0 {
+			left = mid + 1
+		} else {
+			right = mid
+		}
+	}
+
+	return left
+}
+
+// UpperBound returns the first index whose element is > target, or len(arr) if none is
+// arr[LowerBound:UpperBound] is exactly the run of elements equal to target
+// Time Complexity: O(log n)
+// Space Complexity: O(1)
+func UpperBound[T any](arr []T, target T, cmp func(a, b T) int) int {
+	left, right := 0, len(arr)
+
+	for left < right {
+		mid := left + (right-left)/2
+
+		if cmp(arr[mid], target) <= 0 {
+			left = mid + 1
+		} else {
+			right = mid
+		}
+	}
+
+	return left
+}
+
 // BinarySearchRecursive implements binary search recursively
 // Time Complexity: O(log n)
 // Space Complexity: O(log n) due to call stack
@@ -212,3 +266,102 @@ func SquareRoot(x int) int {
 
 	return result
 }
+
+// BinarySearchPredicate finds the smallest value in [lo, hi] for which pred is true
+// pred must be monotonic over the range: false, false, ..., true, true. This is "binary
+// search on the answer": search the space of candidate answers instead of an array, e.g.
+// the smallest ship capacity that fits the load into D days, or SquareRoot as the
+// smallest r with r² > x, minus one.
+// Returns hi+1 if pred is false everywhere in the range (hi must be below math.MaxInt)
+// Time Complexity: O(log(hi - lo)) calls to pred
+// Space Complexity: O(1)
+func BinarySearchPredicate(lo, hi int, pred func(int) bool) int {
+	// Half-open [left, right): right = hi+1 stands for "no value in range works"
+	left, right := lo, hi+1
+
+	for left < right {
+		mid := left + (right-left)/2
+
+		if pred(mid) {
+			right = mid // mid works; the answer is mid or something smaller
+		} else {
+			left = mid + 1
+		}
+	}
+
+	return left
+}
+
+// KthSmallestInMatrix finds the kth smallest value (1-indexed) in a matrix whose rows and
+// columns are each sorted ascending, by binary searching the value range rather than indices
+// Returns 0 if the matrix is empty or k is out of range
+// Time Complexity: O((n + m) * log(max - min))
+// Space Complexity: O(1)
+func KthSmallestInMatrix(matrix [][]int, k int) int {
+	if len(matrix) == 0 || len(matrix[0]) == 0 || k < 1 || k > len(matrix)*len(matrix[0]) {
+		return 0
+	}
+
+	rows, cols := len(matrix), len(matrix[0])
+	left, right := matrix[0][0], matrix[rows-1][cols-1]
+
+	// Find the smallest value v with at least k elements <= v; it must be in the matrix
+	for left < right {
+		mid := left + (right-left)/2
+
+		if countAtMost(matrix, mid) < k {
+			left = mid + 1
+		} else {
+			right = mid
+		}
+	}
+
+	return left
+}
+
+// countAtMost counts elements <= target by walking a staircase from the bottom-left corner
+func countAtMost(matrix [][]int, target int) int {
+	count := 0
+	row, col := len(matrix)-1, 0
+
+	for row >= 0 && col < len(matrix[0]) {
+		if matrix[row][col] <= target {
+			// Everything above in this column is also <= target
+			count += row + 1
+			col++
+		} else {
+			row--
+		}
+	}
+
+	return count
+}
+
+// Search2DMatrix finds target in a matrix whose rows are sorted and whose every row starts
+// above the previous row's last element, by treating it as one flattened sorted array
+// Index i maps to matrix[i/cols][i%cols]. Returns -1, -1, false if target is absent.
+// Time Complexity: O(log(m * n))
+// Space Complexity: O(1)
+func Search2DMatrix(matrix [][]int, target int) (row, col int, found bool) {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return -1, -1, false
+	}
+
+	cols := len(matrix[0])
+	left, right := 0, len(matrix)*cols-1
+
+	for left <= right {
+		mid := left + (right-left)/2
+		value := matrix[mid/cols][mid%cols]
+
+		if value == target {
+			return mid / cols, mid % cols, true
+		} else if value < target {
+			left = mid + 1
+		} else {
+			right = mid - 1
+		}
+	}
+
+	return -1, -1, false
+}
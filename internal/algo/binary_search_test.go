@@ -1,6 +1,10 @@
 package algo
 
-import "testing"
+import (
+	"cmp"
+	"strings"
+	"testing"
+)
 
 func TestBinarySearch_Found(t *testing.T) {
 	arr := []int{1, 3, 5, 7, 9, 11, 13}
@@ -187,3 +191,256 @@ func TestBinarySearch_EdgeCases(t *testing.T) {
 		t.Error("failed to find middle element")
 	}
 }
+
+func TestKthSmallestInMatrix(t *testing.T) {
+	matrix := [][]int{
+		{1, 5, 9},
+		{10, 11, 13},
+		{12, 13, 15},
+	}
+	sorted := []int{1, 5, 9, 10, 11, 12, 13, 13, 15}
+
+	for k := 1; k <= len(sorted); k++ {
+		result := KthSmallestInMatrix(matrix, k)
+		if result != sorted[k-1] {
+			t.Errorf("KthSmallestInMatrix(k=%d): expected %d, got %d", k, sorted[k-1], result)
+		}
+	}
+}
+
+func TestKthSmallestInMatrix_NegativesAndRectangular(t *testing.T) {
+	matrix := [][]int{
+		{-5, -4, 0, 7},
+		{-3, 2, 3, 8},
+	}
+	sorted := []int{-5, -4, -3, 0, 2, 3, 7, 8}
+
+	for k := 1; k <= len(sorted); k++ {
+		result := KthSmallestInMatrix(matrix, k)
+		if result != sorted[k-1] {
+			t.Errorf("KthSmallestInMatrix(k=%d): expected %d, got %d", k, sorted[k-1], result)
+		}
+	}
+}
+
+func TestKthSmallestInMatrix_OutOfRange(t *testing.T) {
+	matrix := [][]int{{1, 2}, {3, 4}}
+
+	if result := KthSmallestInMatrix(matrix, 0); result != 0 {
+		t.Errorf("expected 0 for k=0, got %d", result)
+	}
+	if result := KthSmallestInMatrix(matrix, 5); result != 0 {
+		t.Errorf("expected 0 for k > n*m, got %d", result)
+	}
+	if result := KthSmallestInMatrix([][]int{}, 1); result != 0 {
+		t.Errorf("expected 0 for empty matrix, got %d", result)
+	}
+}
+
+func TestBinarySearchFunc_Strings(t *testing.T) {
+	words := []string{"apple", "banana", "cherry", "date", "fig", "grape"}
+
+	tests := []struct {
+		target   string
+		expected int
+	}{
+		{"apple", 0},
+		{"date", 3},
+		{"grape", 5},
+		{"coconut", -1},
+		{"aardvark", -1}, // Below all
+		{"zucchini", -1}, // Above all
+	}
+
+	for _, tt := range tests {
+		result := BinarySearchFunc(words, tt.target, strings.Compare)
+		if result != tt.expected {
+			t.Errorf("BinarySearchFunc(%q): expected %d, got %d", tt.target, tt.expected, result)
+		}
+	}
+
+	if BinarySearchFunc([]string{}, "x", strings.Compare) != -1 {
+		t.Error("expected -1 for empty slice")
+	}
+}
+
+func TestBinarySearchFunc_Structs(t *testing.T) {
+	type event struct {
+		timestamp int
+		name      string
+	}
+	byTime := func(a, b event) int { return cmp.Compare(a.timestamp, b.timestamp) }
+
+	events := []event{{10, "boot"}, {20, "login"}, {20, "fetch"}, {35, "save"}, {50, "logout"}}
+
+	// Only the timestamp is compared, so the probe's name doesn't matter
+	if i := BinarySearchFunc(events, event{timestamp: 35}, byTime); i != 3 {
+		t.Errorf("expected index 3, got %d", i)
+	}
+	if i := BinarySearchFunc(events, event{timestamp: 20}, byTime); i != 1 {
+		t.Errorf("expected first match at index 1, got %d", i)
+	}
+	if i := BinarySearchFunc(events, event{timestamp: 25}, byTime); i != -1 {
+		t.Errorf("expected -1, got %d", i)
+	}
+
+	// Ranged query: events with 15 <= timestamp <= 40
+	lo := LowerBound(events, event{timestamp: 15}, byTime)
+	hi := UpperBound(events, event{timestamp: 40}, byTime)
+	var names []string
+	for _, e := range events[lo:hi] {
+		names = append(names, e.name)
+	}
+	if strings.Join(names, ",") != "login,fetch,save" {
+		t.Errorf("expected login,fetch,save, got %v", names)
+	}
+}
+
+func TestLowerUpperBound(t *testing.T) {
+	arr := []int{1, 2, 2, 2, 3, 5, 5, 8}
+
+	tests := []struct {
+		name   string
+		target int
+		lower  int
+		upper  int
+	}{
+		{"duplicates", 2, 1, 4},
+		{"duplicates at end of run", 5, 5, 7},
+		{"single occurrence", 3, 4, 5},
+		{"missing, between", 4, 5, 5},
+		{"below all", 0, 0, 0},
+		{"above all", 9, 8, 8},
+		{"first element", 1, 0, 1},
+		{"last element", 8, 7, 8},
+	}
+
+	for _, tt := range tests {
+		lower := LowerBound(arr, tt.target, cmp.Compare[int])
+		upper := UpperBound(arr, tt.target, cmp.Compare[int])
+
+		if lower != tt.lower || upper != tt.upper {
+			t.Errorf("bounds(%s, %d): expected [%d, %d), got [%d, %d)", tt.name, tt.target, tt.lower, tt.upper, lower, upper)
+		}
+	}
+
+	if LowerBound([]int{}, 1, cmp.Compare[int]) != 0 || UpperBound([]int{}, 1, cmp.Compare[int]) != 0 {
+		t.Error("expected 0 for both bounds on an empty slice")
+	}
+}
+
+func TestBinarySearchPredicate_SquareRoot(t *testing.T) {
+	for x := 0; x <= 1000; x++ {
+		// First r whose square exceeds x, minus one, is floor(sqrt(x))
+		root := BinarySearchPredicate(0, x+1, func(r int) bool { return r*r > x }) - 1
+
+		if root != SquareRoot(x) {
+			t.Errorf("sqrt(%d): expected %d, got %d", x, SquareRoot(x), root)
+		}
+	}
+}
+
+func TestBinarySearchPredicate_Threshold(t *testing.T) {
+	tests := []struct {
+		name      string
+		lo, hi    int
+		threshold int
+		expected  int
+	}{
+		{"middle", 0, 100, 37, 37},
+		{"true everywhere", 0, 100, -5, 0},
+		{"only hi is true", 0, 100, 100, 100},
+		{"false everywhere", 0, 100, 101, 101}, // hi+1 by convention
+		{"negative range", -50, -10, -23, -23},
+		{"single value, true", 7, 7, 7, 7},
+		{"single value, false", 7, 7, 8, 8},
+	}
+
+	for _, tt := range tests {
+		calls := 0
+		result := BinarySearchPredicate(tt.lo, tt.hi, func(v int) bool {
+			calls++
+			return v >= tt.threshold
+		})
+
+		if result != tt.expected {
+			t.Errorf("BinarySearchPredicate(%s): expected %d, got %d", tt.name, tt.expected, result)
+		}
+		if calls > 8 { // ceil(log2(101)) = 7
+			t.Errorf("BinarySearchPredicate(%s): expected O(log n) predicate calls, got %d", tt.name, calls)
+		}
+	}
+}
+
+func TestBinarySearchPredicate_ShipWithinDays(t *testing.T) {
+	weights := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	days := 5
+
+	// Greedily load packages in order; a capacity works if it needs at most `days` trips
+	fits := func(capacity int) bool {
+		needed, load := 1, 0
+		for _, w := range weights {
+			if load+w > capacity {
+				needed++
+				load = 0
+			}
+			load += w
+		}
+		return needed <= days
+	}
+
+	// Capacity is at least the heaviest package and at most the total weight
+	capacity := BinarySearchPredicate(10, 55, fits)
+	if capacity != 15 {
+		t.Errorf("expected minimum capacity 15, got %d", capacity)
+	}
+}
+
+func TestSearch2DMatrix(t *testing.T) {
+	matrix := [][]int{
+		{1, 3, 5, 7},
+		{10, 11, 16, 20},
+		{23, 30, 34, 60},
+	}
+
+	tests := []struct {
+		name     string
+		target   int
+		row, col int
+		found    bool
+	}{
+		{"middle", 16, 1, 2, true},
+		{"first cell", 1, 0, 0, true},
+		{"last cell", 60, 2, 3, true},
+		{"row boundary", 23, 2, 0, true},
+		{"smaller than all", 0, -1, -1, false},
+		{"larger than all", 61, -1, -1, false},
+		{"between rows", 8, -1, -1, false},
+		{"missing inside a row", 12, -1, -1, false},
+	}
+
+	for _, tt := range tests {
+		row, col, found := Search2DMatrix(matrix, tt.target)
+		if row != tt.row || col != tt.col || found != tt.found {
+			t.Errorf("Search2DMatrix(%s, %d): expected (%d, %d, %v), got (%d, %d, %v)",
+				tt.name, tt.target, tt.row, tt.col, tt.found, row, col, found)
+		}
+	}
+}
+
+func TestSearch2DMatrix_EmptyAndSingleCell(t *testing.T) {
+	if _, _, found := Search2DMatrix(nil, 1); found {
+		t.Error("nil matrix should not find anything")
+	}
+	if _, _, found := Search2DMatrix([][]int{{}}, 1); found {
+		t.Error("matrix with an empty row should not find anything")
+	}
+
+	single := [][]int{{5}}
+	if row, col, found := Search2DMatrix(single, 5); !found || row != 0 || col != 0 {
+		t.Errorf("expected (0, 0, true), got (%d, %d, %v)", row, col, found)
+	}
+	if _, _, found := Search2DMatrix(single, 4); found {
+		t.Error("expected 4 to be missing from [[5]]")
+	}
+}
@@ -0,0 +1,64 @@
+package algo
+
+// Why interviewers ask this:
+// Bit manipulation questions check whether you know the algebra of XOR and bit counting,
+// which turns problems that look like they need a hash map into O(1)-space one-liners.
+// They come up in phone screens precisely because the trick is small but not obvious.
+
+// Common pitfalls:
+// - Reaching for a map[int]int (correct, but O(n) space when O(1) is the point)
+// - Assuming the three-times variant can also be solved with plain XOR
+// - Ignoring negative numbers: the sign bit must be counted like any other bit
+// - Counting set bits by testing all 64 positions when x &= x-1 skips zeros
+
+// Key takeaway:
+// x ^ x = 0 and x ^ 0 = x, so XOR-ing everything cancels pairs. For "three times", count
+// each bit position mod 3: what's left is the unique number's bit. x & (x-1) clears the
+// lowest set bit, so counting bits takes one step per set bit.
+
+// SingleNumber finds the element that appears once when every other appears twice
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func SingleNumber(nums []int) int {
+	result := 0
+	for _, num := range nums {
+		result ^= num // Pairs cancel out
+	}
+	return result
+}
+
+// SingleNumberII finds the element that appears once when every other appears three times
+// Counts how many numbers have each bit set; counts not divisible by 3 belong to the answer
+// Time Complexity: O(64 * n)
+// Space Complexity: O(1)
+func SingleNumberII(nums []int) int {
+	var result uint64
+
+	for bit := 0; bit < 64; bit++ {
+		count := 0
+		for _, num := range nums {
+			count += int(uint64(num) >> bit & 1)
+		}
+
+		if count%3 != 0 {
+			result |= 1 << bit
+		}
+	}
+
+	return int(result) // Restores the sign bit for negative answers
+}
+
+// HammingDistance counts the bit positions where a and b differ
+// Time Complexity: O(k) where k is the number of differing bits
+// Space Complexity: O(1)
+func HammingDistance(a, b int) int {
+	diff := uint64(a ^ b)
+
+	distance := 0
+	for diff != 0 {
+		diff &= diff - 1 // Clear the lowest set bit
+		distance++
+	}
+
+	return distance
+}
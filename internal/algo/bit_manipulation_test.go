@@ -0,0 +1,61 @@
+package algo
+
+import "testing"
+
+func TestSingleNumber(t *testing.T) {
+	tests := []struct {
+		nums     []int
+		expected int
+	}{
+		{[]int{4, 1, 2, 1, 2}, 4},
+		{[]int{2, 2, 1}, 1},
+		{[]int{1}, 1},
+		{[]int{-3, 7, 7}, -3},
+	}
+
+	for _, tt := range tests {
+		result := SingleNumber(tt.nums)
+		if result != tt.expected {
+			t.Errorf("SingleNumber(%v): expected %d, got %d", tt.nums, tt.expected, result)
+		}
+	}
+}
+
+func TestSingleNumberII(t *testing.T) {
+	tests := []struct {
+		nums     []int
+		expected int
+	}{
+		{[]int{2, 2, 3, 2}, 3},
+		{[]int{0, 1, 0, 1, 0, 1, 99}, 99},
+		{[]int{-2, -2, 1, 1, 4, 1, 4, 4, -5, -2}, -5},
+		{[]int{7}, 7},
+	}
+
+	for _, tt := range tests {
+		result := SingleNumberII(tt.nums)
+		if result != tt.expected {
+			t.Errorf("SingleNumberII(%v): expected %d, got %d", tt.nums, tt.expected, result)
+		}
+	}
+}
+
+func TestHammingDistance(t *testing.T) {
+	tests := []struct {
+		a, b     int
+		expected int
+	}{
+		{1, 4, 2}, // 001 vs 100
+		{3, 1, 1},
+		{0, 0, 0},
+		{255, 0, 8},
+		{-1, 0, 64},
+	}
+
+	for _, tt := range tests {
+		result := HammingDistance(tt.a, tt.b)
+		if result != tt.expected {
+			t.Errorf("HammingDistance(%d, %d): expected %d, got %d", tt.a, tt.b, tt.expected, result)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package algo
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Why interviewers ask this:
+// Diffing is the longest common subsequence (LCS) problem put to work: every line-diff
+// tool, code review UI, and text-sync protocol is built on it. Interviewers like it
+// because it moves past "compute the LCS length" to "reconstruct the edit script",
+// which tests whether you can walk a DP table backwards.
+
+// Common pitfalls:
+// - Computing only the LCS length and not keeping the table needed to backtrack
+// - Backtracking in the wrong direction and emitting operations in reverse order
+// - Forgetting the leftover prefix of a or b once the other side is exhausted
+// - Treating Apply as "replay the inserts" and ignoring the kept elements from a
+// - Trusting the script in Apply: one built for a different input indexes past the end of a
+
+// Key takeaway:
+// Build the LCS table for suffixes, then walk forward from (0, 0): equal elements are
+// Keep, otherwise move in the direction that preserves the longer LCS (Delete from a
+// or Insert from b). Elements in the LCS are kept; everything else is an edit.
+
+// DiffOpKind identifies the kind of edit in a diff script
+type DiffOpKind int
+
+const (
+	// DiffKeep keeps the next element of a
+	DiffKeep DiffOpKind = iota
+	// DiffInsert inserts Value into the output
+	DiffInsert
+	// DiffDelete skips the next element of a
+	DiffDelete
+)
+
+// String returns a short, diff-style marker for the operation kind
+func (k DiffOpKind) String() string {
+	switch k {
+	case DiffKeep:
+		return " "
+	case DiffInsert:
+		return "+"
+	case DiffDelete:
+		return "-"
+	default:
+		return "?"
+	}
+}
+
+// ErrDiffMismatch is returned by Apply when a script was not produced for the given input
+var ErrDiffMismatch = errors.New("diff: script does not match input")
+
+// DiffOp is a single edit operation in a diff script
+type DiffOp[T any] struct {
+	Kind  DiffOpKind
+	Value T
+}
+
+// Diff returns a minimal sequence of Keep/Insert/Delete operations that transforms a into b
+// Time Complexity: O(n * m)
+// Space Complexity: O(n * m) for the LCS table
+func Diff[T comparable](a, b []T) []DiffOp[T] {
+	n, m := len(a), len(b)
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = maxInt(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	// Walk the table forward so operations come out in order
+	ops := make([]DiffOp[T], 0, n+m-lcs[0][0])
+	i, j := 0, 0
+	for i < n && j < m {
+		if a[i] == b[j] {
+			ops = append(ops, DiffOp[T]{Kind: DiffKeep, Value: a[i]})
+			i++
+			j++
+		} else if lcs[i+1][j] >= lcs[i][j+1] {
+			ops = append(ops, DiffOp[T]{Kind: DiffDelete, Value: a[i]})
+			i++
+		} else {
+			ops = append(ops, DiffOp[T]{Kind: DiffInsert, Value: b[j]})
+			j++
+		}
+	}
+
+	// Whatever is left on either side is a pure delete or insert
+	for ; i < n; i++ {
+		ops = append(ops, DiffOp[T]{Kind: DiffDelete, Value: a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, DiffOp[T]{Kind: DiffInsert, Value: b[j]})
+	}
+
+	return ops
+}
+
+// Apply replays a diff script against a and returns the resulting slice
+// Keep and Delete consume the next element of a; Insert emits op.Value.
+// Returns an error wrapping ErrDiffMismatch if the script consumes more or fewer than
+// len(a) elements, or contains an unknown operation kind.
+// Time Complexity: O(len(ops))
+// Space Complexity: O(len(ops))
+func Apply[T any](a []T, ops []DiffOp[T]) ([]T, error) {
+	result := make([]T, 0, len(ops))
+	i := 0
+
+	for pos, op := range ops {
+		switch op.Kind {
+		case DiffKeep, DiffDelete:
+			if i >= len(a) {
+				return nil, fmt.Errorf("%w: op %d (%q) runs past the %d input elements", ErrDiffMismatch, pos, op.Kind, len(a))
+			}
+			if op.Kind == DiffKeep {
+				result = append(result, a[i])
+			}
+			i++
+		case DiffInsert:
+			result = append(result, op.Value)
+		default:
+			return nil, fmt.Errorf("%w: op %d has unknown kind %d", ErrDiffMismatch, pos, int(op.Kind))
+		}
+	}
+
+	// Every element of a must be either kept or deleted, or the rest would vanish silently
+	if i != len(a) {
+		return nil, fmt.Errorf("%w: script consumed %d of %d input elements", ErrDiffMismatch, i, len(a))
+	}
+
+	return result, nil
+}
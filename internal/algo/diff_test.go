@@ -0,0 +1,109 @@
+package algo
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiff_Lines(t *testing.T) {
+	a := []string{"a", "b", "c", "d"}
+	b := []string{"a", "c", "d", "e"}
+
+	ops := Diff(a, b)
+
+	expected := []DiffOp[string]{
+		{Kind: DiffKeep, Value: "a"},
+		{Kind: DiffDelete, Value: "b"},
+		{Kind: DiffKeep, Value: "c"},
+		{Kind: DiffKeep, Value: "d"},
+		{Kind: DiffInsert, Value: "e"},
+	}
+	if !reflect.DeepEqual(ops, expected) {
+		t.Errorf("expected %v, got %v", expected, ops)
+	}
+}
+
+func TestDiff_KeepCountEqualsLCS(t *testing.T) {
+	a := strings.Split("ABCBDAB", "")
+	b := strings.Split("BDCABA", "")
+
+	keeps := 0
+	for _, op := range Diff(a, b) {
+		if op.Kind == DiffKeep {
+			keeps++
+		}
+	}
+
+	// LCS of ABCBDAB and BDCABA has length 4 (e.g. BCBA)
+	if keeps != 4 {
+		t.Errorf("expected 4 kept elements, got %d", keeps)
+	}
+}
+
+func TestDiff_ApplyRoundTrip(t *testing.T) {
+	tests := []struct {
+		a []int
+		b []int
+	}{
+		{[]int{1, 2, 3}, []int{1, 2, 3}},
+		{[]int{}, []int{1, 2, 3}},
+		{[]int{1, 2, 3}, []int{}},
+		{[]int{}, []int{}},
+		{[]int{1, 2, 3}, []int{4, 5, 6}},
+		{[]int{1, 2, 3, 4, 5}, []int{0, 2, 4, 6}},
+		{[]int{1, 1, 2, 2}, []int{2, 2, 1, 1}},
+	}
+
+	for _, tt := range tests {
+		result, err := Apply(tt.a, Diff(tt.a, tt.b))
+		if err != nil {
+			t.Errorf("Apply(%v, Diff(%v, %v)): unexpected error %v", tt.a, tt.a, tt.b, err)
+		}
+		if !reflect.DeepEqual(result, tt.b) && !(len(result) == 0 && len(tt.b) == 0) {
+			t.Errorf("Apply(%v, Diff(%v, %v)): expected %v, got %v", tt.a, tt.a, tt.b, tt.b, result)
+		}
+	}
+}
+
+func TestDiff_IdenticalIsAllKeeps(t *testing.T) {
+	a := []string{"x", "y", "z"}
+
+	for _, op := range Diff(a, a) {
+		if op.Kind != DiffKeep {
+			t.Errorf("expected only keeps for identical input, got %v", op.Kind)
+		}
+	}
+}
+
+func TestDiffOpKind_String(t *testing.T) {
+	if DiffKeep.String() != " " || DiffInsert.String() != "+" || DiffDelete.String() != "-" {
+		t.Error("unexpected DiffOpKind markers")
+	}
+}
+
+func TestDiff_ApplyMismatchedScript(t *testing.T) {
+	script := Diff([]int{1, 2, 3}, []int{1, 3, 4})
+
+	tests := []struct {
+		name string
+		a    []int
+		ops  []DiffOp[int]
+	}{
+		{"input too short", []int{1, 2}, script},
+		{"input too long", []int{1, 2, 3, 4}, script},
+		{"empty input", []int{}, []DiffOp[int]{{Kind: DiffKeep, Value: 1}}},
+		{"unknown kind", []int{1}, []DiffOp[int]{{Kind: DiffOpKind(9), Value: 1}}},
+	}
+
+	for _, tt := range tests {
+		result, err := Apply(tt.a, tt.ops)
+		if !errors.Is(err, ErrDiffMismatch) {
+			t.Errorf("Apply(%s): expected ErrDiffMismatch, got %v", tt.name, err)
+		}
+		if result != nil {
+			t.Errorf("Apply(%s): expected nil result, got %v", tt.name, result)
+		}
+	}
+}
@@ -101,6 +101,46 @@ func CoinChange(coins []int, amount int) int {
 	return dp[amount]
 }
 
+// CoinChangeCoins finds the coins in a minimum-coin solution for amount
+// Records the last coin chosen for each sub-amount, then walks back from amount
+// Returns false if amount can't be made
+// Time Complexity: O(amount * len(coins))
+// Space Complexity: O(amount)
+func CoinChangeCoins(coins []int, amount int) ([]int, bool) {
+	if amount < 0 {
+		return nil, false
+	}
+
+	dp := make([]int, amount+1)
+	lastCoin := make([]int, amount+1)
+
+	// Initialize with impossible value
+	for i := 1; i <= amount; i++ {
+		dp[i] = amount + 1
+	}
+
+	for i := 1; i <= amount; i++ {
+		for _, coin := range coins {
+			if coin > 0 && coin <= i && dp[i-coin]+1 < dp[i] {
+				dp[i] = dp[i-coin] + 1
+				lastCoin[i] = coin
+			}
+		}
+	}
+
+	if dp[amount] > amount {
+		return nil, false // Impossible
+	}
+
+	// Reconstruct by repeatedly stepping back by the coin chosen for the remainder
+	result := make([]int, 0, dp[amount])
+	for remaining := amount; remaining > 0; remaining -= lastCoin[remaining] {
+		result = append(result, lastCoin[remaining])
+	}
+
+	return result, true
+}
+
 // LongestIncreasingSubsequence finds length of LIS
 // Time Complexity: O(n²)
 // Space Complexity: O(n)
@@ -148,6 +188,67 @@ func MaxSubarraySum(nums []int) int {
 	return maxSum
 }
 
+// MaxSumSubmatrix finds maximum sum of any rectangular submatrix (Kadane's in 2D)
+// Fixes each pair of top/bottom rows, collapses the rows between them into column sums,
+// and runs MaxSubarraySum on that 1D array. All-negative input yields the largest element.
+// Returns 0 for an empty matrix.
+// Time Complexity: O(rows² * cols)
+// Space Complexity: O(cols)
+func MaxSumSubmatrix(matrix [][]int) int {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return 0
+	}
+
+	rows, cols := len(matrix), len(matrix[0])
+	best := matrix[0][0]
+	columnSums := make([]int, cols)
+
+	for top := 0; top < rows; top++ {
+		for j := range columnSums {
+			columnSums[j] = 0
+		}
+
+		for bottom := top; bottom < rows; bottom++ {
+			// Extend the band [top, bottom] by one row
+			for j := 0; j < cols; j++ {
+				columnSums[j] += matrix[bottom][j]
+			}
+			best = maxInt(best, MaxSubarraySum(columnSums))
+		}
+	}
+
+	return best
+}
+
+// MaxProductSubarray finds maximum product of contiguous subarray
+// Tracks both the largest and smallest product ending at each index, because
+// multiplying by a negative number swaps them. A zero resets both.
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func MaxProductSubarray(nums []int) int {
+	if len(nums) == 0 {
+		return 0
+	}
+
+	maxProduct := nums[0]
+	currentMax := nums[0]
+	currentMin := nums[0]
+
+	for i := 1; i < len(nums); i++ {
+		if nums[i] < 0 {
+			// A negative flips which running product is the candidate for the max
+			currentMax, currentMin = currentMin, currentMax
+		}
+
+		// Either extend the running products or start fresh at nums[i]
+		currentMax = maxInt(nums[i], currentMax*nums[i])
+		currentMin = minInt(nums[i], currentMin*nums[i])
+		maxProduct = maxInt(maxProduct, currentMax)
+	}
+
+	return maxProduct
+}
+
 // HouseRobber finds maximum money that can be robbed (can't rob adjacent houses)
 // Time Complexity: O(n)
 // Space Complexity: O(1)
@@ -198,6 +299,115 @@ func UniquePaths(m, n int) int {
 	return dp[m-1][n-1]
 }
 
+// MinPathSum finds the minimum sum of a path from top-left to bottom-right moving only
+// right or down. Uses a single row of DP: dp[j] is the best sum to reach column j of the
+// current row. Returns 0 for an empty grid.
+// Time Complexity: O(m * n)
+// Space Complexity: O(n)
+func MinPathSum(grid [][]int) int {
+	if len(grid) == 0 || len(grid[0]) == 0 {
+		return 0
+	}
+
+	cols := len(grid[0])
+	dp := make([]int, cols)
+
+	dp[0] = grid[0][0]
+	for j := 1; j < cols; j++ {
+		dp[j] = dp[j-1] + grid[0][j]
+	}
+
+	for i := 1; i < len(grid); i++ {
+		dp[0] += grid[i][0]
+		for j := 1; j < cols; j++ {
+			// Come from above (dp[j]) or from the left (dp[j-1])
+			dp[j] = minInt(dp[j], dp[j-1]) + grid[i][j]
+		}
+	}
+
+	return dp[cols-1]
+}
+
+// LongestPalindromicSubsequence finds length of the longest subsequence of s that reads
+// the same backwards (characters need not be contiguous). Works on runes.
+// dp[i][j] = answer for runes[i..j]: matching ends add 2 to the inside, otherwise drop one end
+// Time Complexity: O(n²)
+// Space Complexity: O(n²)
+func LongestPalindromicSubsequence(s string) int {
+	runes := []rune(s)
+	n := len(runes)
+	if n == 0 {
+		return 0
+	}
+
+	dp := make([][]int, n)
+	for i := range dp {
+		dp[i] = make([]int, n)
+		dp[i][i] = 1 // Every single character is a palindrome
+	}
+
+	// Fill by increasing interval length so inner intervals are ready
+	for length := 2; length <= n; length++ {
+		for i := 0; i+length-1 < n; i++ {
+			j := i + length - 1
+
+			if runes[i] == runes[j] {
+				dp[i][j] = dp[i+1][j-1] + 2 // dp[i+1][j-1] is 0 when length == 2
+			} else {
+				dp[i][j] = maxInt(dp[i+1][j], dp[i][j-1])
+			}
+		}
+	}
+
+	return dp[0][n-1]
+}
+
+// IsMatch reports whether s fully matches pattern, where '.' matches any single
+// character and '*' matches zero or more of the preceding element
+// dp[i][j] = s[:i] matches pattern[:j]
+// Time Complexity: O(m * n)
+// Space Complexity: O(m * n)
+func IsMatch(s, pattern string) bool {
+	m, n := len(s), len(pattern)
+
+	dp := make([][]bool, m+1)
+	for i := range dp {
+		dp[i] = make([]bool, n+1)
+	}
+	dp[0][0] = true
+
+	// Empty string can match patterns like "a*", "a*b*", ".*"
+	for j := 2; j <= n; j++ {
+		if pattern[j-1] == '*' {
+			dp[0][j] = dp[0][j-2]
+		}
+	}
+
+	for i := 1; i <= m; i++ {
+		for j := 1; j <= n; j++ {
+			if pattern[j-1] == '*' {
+				if j < 2 {
+					continue // '*' with nothing to repeat never matches
+				}
+				// Zero occurrences: drop "x*" from the pattern
+				dp[i][j] = dp[i][j-2]
+				// One more occurrence: s[i-1] must match the repeated element
+				if charMatches(s[i-1], pattern[j-2]) {
+					dp[i][j] = dp[i][j] || dp[i-1][j]
+				}
+			} else if charMatches(s[i-1], pattern[j-1]) {
+				dp[i][j] = dp[i-1][j-1]
+			}
+		}
+	}
+
+	return dp[m][n]
+}
+
+func charMatches(c, p byte) bool {
+	return p == '.' || c == p
+}
+
 // Helper functions
 func minInt(a, b int) int {
 	if a < b {
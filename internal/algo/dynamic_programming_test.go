@@ -84,6 +84,50 @@ func TestCoinChange(t *testing.T) {
 	}
 }
 
+func TestCoinChangeCoins(t *testing.T) {
+	tests := []struct {
+		coins  []int
+		amount int
+	}{
+		{[]int{1, 2, 5}, 11},
+		{[]int{1, 3, 4}, 6}, // Greedy picks 4+1+1; optimal is 3+3
+		{[]int{2, 5, 10, 1}, 27},
+		{[]int{186, 419, 83, 408}, 6249},
+		{[]int{1}, 0},
+	}
+
+	for _, tt := range tests {
+		result, ok := CoinChangeCoins(tt.coins, tt.amount)
+		if !ok {
+			t.Errorf("CoinChangeCoins(%v, %d): expected a solution", tt.coins, tt.amount)
+			continue
+		}
+
+		sum := 0
+		for _, coin := range result {
+			sum += coin
+		}
+		if sum != tt.amount {
+			t.Errorf("CoinChangeCoins(%v, %d): coins %v sum to %d", tt.coins, tt.amount, result, sum)
+		}
+
+		expectedCount := CoinChange(tt.coins, tt.amount)
+		if len(result) != expectedCount {
+			t.Errorf("CoinChangeCoins(%v, %d): expected %d coins, got %v",
+				tt.coins, tt.amount, expectedCount, result)
+		}
+	}
+}
+
+func TestCoinChangeCoins_Impossible(t *testing.T) {
+	if result, ok := CoinChangeCoins([]int{2}, 3); ok {
+		t.Errorf("expected no solution, got %v", result)
+	}
+	if result, ok := CoinChangeCoins([]int{5, 10}, 7); ok {
+		t.Errorf("expected no solution, got %v", result)
+	}
+}
+
 func TestLongestIncreasingSubsequence(t *testing.T) {
 	tests := []struct {
 		nums     []int
@@ -124,6 +168,61 @@ func TestMaxSubarraySum(t *testing.T) {
 	}
 }
 
+func TestMaxSumSubmatrix(t *testing.T) {
+	tests := []struct {
+		name     string
+		matrix   [][]int
+		expected int
+	}{
+		{
+			"classic 4x5",
+			[][]int{
+				{1, 2, -1, -4, -20},
+				{-8, -3, 4, 2, 1},
+				{3, 8, 10, 1, 3},
+				{-4, -1, 1, 7, -6},
+			},
+			29, // rows 1-3, cols 1-3
+		},
+		{"all negative", [][]int{{-3, -2}, {-5, -1}}, -1},
+		{"single cell", [][]int{{4}}, 4},
+		{"whole matrix", [][]int{{1, 2}, {3, 4}}, 10},
+		{"single row", [][]int{{2, -1, 3, -5, 4}}, 4},
+		{"empty", [][]int{}, 0},
+	}
+
+	for _, tt := range tests {
+		result := MaxSumSubmatrix(tt.matrix)
+		if result != tt.expected {
+			t.Errorf("MaxSumSubmatrix(%s): expected %d, got %d", tt.name, tt.expected, result)
+		}
+	}
+}
+
+func TestMaxProductSubarray(t *testing.T) {
+	tests := []struct {
+		nums     []int
+		expected int
+	}{
+		{[]int{2, 3, -2, 4}, 6}, // [2,3]
+		{[]int{-2, 0, -1}, 0},   // zero beats any negative
+		{[]int{-2, 3, -4}, 24},  // two negatives cancel
+		{[]int{-3, -1, -1}, 3},  // all negative
+		{[]int{-2}, -2},
+		{[]int{0, 2, -1, 4, 0, 3}, 4}, // zeros split the array
+		{[]int{2, -5, -2, -4, 3}, 24},
+		{[]int{}, 0},
+	}
+
+	for _, tt := range tests {
+		result := MaxProductSubarray(tt.nums)
+		if result != tt.expected {
+			t.Errorf("MaxProductSubarray(%v): expected %d, got %d",
+				tt.nums, tt.expected, result)
+		}
+	}
+}
+
 func TestHouseRobber(t *testing.T) {
 	tests := []struct {
 		nums     []int
@@ -187,3 +286,76 @@ func TestDP_EdgeCases(t *testing.T) {
 		t.Error("UniquePaths(1,1) should be 1")
 	}
 }
+
+func TestMinPathSum(t *testing.T) {
+	tests := []struct {
+		grid     [][]int
+		expected int
+	}{
+		{[][]int{{1, 3, 1}, {1, 5, 1}, {4, 2, 1}}, 7}, // 1→3→1→1→1
+		{[][]int{{1, 2, 3}, {4, 5, 6}}, 12},
+		{[][]int{{5}}, 5},
+		{[][]int{{1}, {2}, {3}}, 6},
+		{[][]int{}, 0},
+	}
+
+	for _, tt := range tests {
+		result := MinPathSum(tt.grid)
+		if result != tt.expected {
+			t.Errorf("MinPathSum(%v): expected %d, got %d", tt.grid, tt.expected, result)
+		}
+	}
+}
+
+func TestLongestPalindromicSubsequence(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected int
+	}{
+		{"bbbab", 4}, // "bbbb"
+		{"cbbd", 2},  // "bb"
+		{"", 0},
+		{"a", 1},
+		{"abcde", 1},
+		{"agbdba", 5}, // "abdba"
+		{"racecar", 7},
+		{"ñaña", 3}, // Rune-aware: "aña" or "ñañ"
+	}
+
+	for _, tt := range tests {
+		result := LongestPalindromicSubsequence(tt.s)
+		if result != tt.expected {
+			t.Errorf("LongestPalindromicSubsequence(%q): expected %d, got %d", tt.s, tt.expected, result)
+		}
+	}
+}
+
+func TestIsMatch(t *testing.T) {
+	tests := []struct {
+		s        string
+		pattern  string
+		expected bool
+	}{
+		{"aa", "a", false},
+		{"aa", "a*", true},
+		{"ab", ".*", true},
+		{"aab", "c*a*b", true},
+		{"mississippi", "mis*is*p*.", false},
+		{"mississippi", "mis*is*ip*.", true},
+		{"", "", true},
+		{"", "a*", true},
+		{"", ".*b*", true},
+		{"", ".", false},
+		{"a", "", false},
+		{"abc", "a.c", true},
+		{"abcd", "a.c", false},
+		{"a", "*", false},
+	}
+
+	for _, tt := range tests {
+		result := IsMatch(tt.s, tt.pattern)
+		if result != tt.expected {
+			t.Errorf("IsMatch(%q, %q): expected %v, got %v", tt.s, tt.pattern, tt.expected, result)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package algo
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+// Why interviewers ask this:
+// "How does the standard library sort?" is a common follow-up to QuickSort. The answer is
+// introsort: QuickSort for speed, HeapSort as a safety net when recursion gets too deep,
+// and InsertionSort for tiny ranges. It keeps QuickSort's constants but guarantees
+// O(n log n) even on inputs built to defeat the pivot choice.
+
+// Common pitfalls:
+// - Lomuto partition on many equal keys (every split is n-1 / 0, so O(n²))
+// - No depth limit: a bad pivot sequence still degrades to O(n²) and deep recursion
+// - Recursing into both halves instead of looping on the larger one (O(n) stack)
+// - Using <= in a less function, which breaks the strict weak ordering sorts rely on
+
+// Key takeaway:
+// Partition with a median-of-three pivot and Hoare's scheme (balanced on duplicates).
+// Allow about 2*log2(n) levels of partitioning; past that, HeapSort the range. Finish
+// ranges of a dozen or so elements with InsertionSort. The result is in-place and unstable;
+// when equal elements must keep their order, pay O(n) scratch for merge sort instead.
+
+// insertionThreshold is the range size below which InsertionSort beats partitioning
+const insertionThreshold = 12
+
+// SortOrdered sorts arr in ascending order in place using introsort
+// Time Complexity: O(n log n) worst case
+// Space Complexity: O(log n) for recursion stack
+func SortOrdered[T cmp.Ordered](arr []T) {
+	SortFunc(arr, func(a, b T) bool { return a < b })
+}
+
+// SortFunc sorts arr in place so that less(arr[i], arr[i-1]) is false for every i
+// less must be a strict weak ordering (a < b, never a <= b). The sort is not stable.
+// Time Complexity: O(n log n) worst case
+// Space Complexity: O(log n) for recursion stack
+func SortFunc[T any](arr []T, less func(a, b T) bool) {
+	if len(arr) < 2 {
+		return
+	}
+	introSort(arr, less, 2*bits.Len(uint(len(arr))))
+}
+
+// introSort quicksorts arr until depthLimit partitions have been spent, then heapsorts
+func introSort[T any](arr []T, less func(a, b T) bool, depthLimit int) {
+	for len(arr) > insertionThreshold {
+		if depthLimit == 0 {
+			heapSortFunc(arr, less) // Pivots have been bad; cap the cost at O(n log n)
+			return
+		}
+		depthLimit--
+
+		p := partitionFunc(arr, less)
+
+		// Recurse into the smaller side and loop on the larger to keep the stack O(log n)
+		if p < len(arr)-p {
+			introSort(arr[:p], less, depthLimit)
+			arr = arr[p:]
+		} else {
+			introSort(arr[p:], less, depthLimit)
+			arr = arr[:p]
+		}
+	}
+	insertionSortFunc(arr, less)
+}
+
+// partitionFunc splits arr around a median-of-three pivot using Hoare's scheme
+// Returns p with 0 < p < len(arr) such that arr[:p] <= pivot <= arr[p:]
+func partitionFunc[T any](arr []T, less func(a, b T) bool) int {
+	mid, last := len(arr)/2, len(arr)-1
+
+	// Order arr[0] <= arr[mid] <= arr[last] so the pivot is the median of the three
+	if less(arr[mid], arr[0]) {
+		arr[mid], arr[0] = arr[0], arr[mid]
+	}
+	if less(arr[last], arr[0]) {
+		arr[last], arr[0] = arr[0], arr[last]
+	}
+	if less(arr[last], arr[mid]) {
+		arr[last], arr[mid] = arr[mid], arr[last]
+	}
+	pivot := arr[mid]
+
+	// Both scans stop on elements equal to the pivot, so runs of duplicates split evenly
+	i, j := -1, len(arr)
+	for {
+		for i++; less(arr[i], pivot); i++ {
+		}
+		for j--; less(pivot, arr[j]); j-- {
+		}
+		if i >= j {
+			return j + 1
+		}
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
+
+// heapSortFunc sorts arr in place with a max heap
+// Time Complexity: O(n log n)
+// Space Complexity: O(1)
+func heapSortFunc[T any](arr []T, less func(a, b T) bool) {
+	n := len(arr)
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDownFunc(arr, i, n, less)
+	}
+	for end := n - 1; end > 0; end-- {
+		arr[0], arr[end] = arr[end], arr[0]
+		siftDownFunc(arr, 0, end, less)
+	}
+}
+
+// siftDownFunc restores the max-heap property for the subtree rooted at i within arr[:n]
+func siftDownFunc[T any](arr []T, i, n int, less func(a, b T) bool) {
+	for {
+		largest := i
+		left, right := 2*i+1, 2*i+2
+
+		if left < n && less(arr[largest], arr[left]) {
+			largest = left
+		}
+		if right < n && less(arr[largest], arr[right]) {
+			largest = right
+		}
+		if largest == i {
+			return
+		}
+
+		arr[i], arr[largest] = arr[largest], arr[i]
+		i = largest
+	}
+}
+
+// insertionSortFunc sorts small ranges where its low overhead wins
+// Time Complexity: O(n²), O(n) when nearly sorted
+// Space Complexity: O(1)
+func insertionSortFunc[T any](arr []T, less func(a, b T) bool) {
+	for i := 1; i < len(arr); i++ {
+		for j := i; j > 0 && less(arr[j], arr[j-1]); j-- {
+			arr[j], arr[j-1] = arr[j-1], arr[j]
+		}
+	}
+}
+
+// StableSortFunc sorts arr in place, keeping elements that compare equal in their original order
+// Top-down merge sort: each merge copies only the left half into a shared scratch buffer and
+// merges back into arr. Taking from the left half on ties is what makes it stable.
+// Time Complexity: O(n log n), O(n) for already sorted input
+// Space Complexity: O(n) scratch
+func StableSortFunc[T any](arr []T, less func(a, b T) bool) {
+	if len(arr) < 2 {
+		return
+	}
+	mergeSortFunc(arr, make([]T, len(arr)/2), less)
+}
+
+// mergeSortFunc sorts arr using scratch, which holds at least len(arr)/2 elements
+func mergeSortFunc[T any](arr, scratch []T, less func(a, b T) bool) {
+	if len(arr) <= insertionThreshold {
+		insertionSortFunc(arr, less) // Stable: it only moves past strictly greater elements
+		return
+	}
+
+	mid := len(arr) / 2
+	mergeSortFunc(arr[:mid], scratch, less)
+	mergeSortFunc(arr[mid:], scratch, less)
+
+	if !less(arr[mid], arr[mid-1]) {
+		return // Halves are already in order
+	}
+
+	left := scratch[:mid]
+	copy(left, arr[:mid])
+
+	i, j, k := 0, mid, 0
+	for i < len(left) && j < len(arr) {
+		if less(arr[j], left[i]) {
+			arr[k] = arr[j]
+			j++
+		} else {
+			arr[k] = left[i] // Ties take from the left half
+			i++
+		}
+		k++
+	}
+
+	// Leftover right-half elements are already in place
+	copy(arr[k:], left[i:])
+}
@@ -0,0 +1,178 @@
+package algo
+
+import (
+	"math/bits"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSortOrdered_Strings(t *testing.T) {
+	arr := []string{"pear", "apple", "fig", "banana", "cherry", "apple"}
+	SortOrdered(arr)
+
+	expected := []string{"apple", "apple", "banana", "cherry", "fig", "pear"}
+	if !reflect.DeepEqual(arr, expected) {
+		t.Errorf("expected %v, got %v", expected, arr)
+	}
+}
+
+func TestSortOrdered_Floats(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	arr := make([]float64, 500) // Large enough to exercise partitioning, not just insertion sort
+	for i := range arr {
+		arr[i] = rng.Float64()*200 - 100
+	}
+	SortOrdered(arr)
+
+	for i := 1; i < len(arr); i++ {
+		if arr[i] < arr[i-1] {
+			t.Fatalf("not sorted at index %d: %v > %v", i, arr[i-1], arr[i])
+		}
+	}
+}
+
+func TestSortOrdered_EdgeCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"nil", nil, nil},
+		{"empty", []int{}, []int{}},
+		{"single", []int{5}, []int{5}},
+		{"two", []int{2, 1}, []int{1, 2}},
+		{"all equal", []int{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3}, []int{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3}},
+	}
+
+	for _, tt := range tests {
+		SortOrdered(tt.input)
+		if !reflect.DeepEqual(tt.input, tt.expected) {
+			t.Errorf("SortOrdered(%s): expected %v, got %v", tt.name, tt.expected, tt.input)
+		}
+	}
+}
+
+func TestSortFunc_StructsByField(t *testing.T) {
+	type employee struct {
+		name string
+		age  int
+	}
+	staff := []employee{{"Dana", 41}, {"Ali", 29}, {"Kim", 35}, {"Lee", 23}, {"Sam", 52}}
+
+	SortFunc(staff, func(a, b employee) bool { return a.age < b.age })
+
+	var names []string
+	for _, e := range staff {
+		names = append(names, e.name)
+	}
+	expected := []string{"Lee", "Ali", "Kim", "Dana", "Sam"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+
+	// Descending by flipping the comparison
+	SortFunc(staff, func(a, b employee) bool { return a.age > b.age })
+	if staff[0].name != "Sam" || staff[len(staff)-1].name != "Lee" {
+		t.Errorf("expected Sam first and Lee last, got %v", staff)
+	}
+}
+
+func TestSortFunc_AdversarialInputsStayNLogN(t *testing.T) {
+	n := 10000
+	// Generous bound; a quadratic sort needs ~n²/2 = 50,000,000 comparisons
+	limit := 4 * n * bits.Len(uint(n))
+
+	inputs := map[string]func(i int) int{
+		"sorted":      func(i int) int { return i },
+		"reverse":     func(i int) int { return n - i },
+		"all equal":   func(i int) int { return 7 },
+		"organ pipe":  func(i int) int { return min(i, n-i) },
+		"few unique":  func(i int) int { return i % 3 },
+		"sawtooth":    func(i int) int { return i % 100 },
+		"alternating": func(i int) int { return (i % 2) * i },
+	}
+
+	for name, gen := range inputs {
+		arr := make([]int, n)
+		for i := range arr {
+			arr[i] = gen(i)
+		}
+
+		comparisons := 0
+		SortFunc(arr, func(a, b int) bool {
+			comparisons++
+			return a < b
+		})
+
+		if !IsSorted(arr) {
+			t.Errorf("SortFunc(%s): result not sorted", name)
+		}
+		if comparisons > limit {
+			t.Errorf("SortFunc(%s): expected at most %d comparisons, got %d", name, limit, comparisons)
+		}
+	}
+}
+
+func TestIntroSort_HeapSortFallback(t *testing.T) {
+	// A depth limit of 0 skips partitioning entirely, as if every pivot had been bad
+	n := 1000
+	arr := make([]int, n)
+	for i := range arr {
+		arr[i] = n - i
+	}
+
+	comparisons := 0
+	introSort(arr, func(a, b int) bool {
+		comparisons++
+		return a < b
+	}, 0)
+
+	if !IsSorted(arr) {
+		t.Error("heapsort fallback did not sort the input")
+	}
+	if limit := 3 * n * bits.Len(uint(n)); comparisons > limit {
+		t.Errorf("expected at most %d comparisons from heapsort, got %d", limit, comparisons)
+	}
+}
+
+func TestStableSortFunc_KeepsEqualKeysInOrder(t *testing.T) {
+	type record struct {
+		key int
+		pos int // Original position, to detect reordering of equal keys
+	}
+
+	rng := rand.New(rand.NewSource(11))
+	records := make([]record, 500)
+	for i := range records {
+		records[i] = record{key: rng.Intn(10), pos: i} // Only 10 keys, so many ties
+	}
+
+	expected := make([]record, len(records))
+	copy(expected, records)
+	sort.SliceStable(expected, func(i, j int) bool { return expected[i].key < expected[j].key })
+
+	StableSortFunc(records, func(a, b record) bool { return a.key < b.key })
+
+	if !reflect.DeepEqual(records, expected) {
+		t.Fatal("StableSortFunc result differs from sort.SliceStable")
+	}
+	for i := 1; i < len(records); i++ {
+		if records[i].key == records[i-1].key && records[i].pos < records[i-1].pos {
+			t.Errorf("equal keys reordered at index %d: pos %d before %d", i, records[i-1].pos, records[i].pos)
+		}
+	}
+}
+
+func TestStableSortFunc_Strings(t *testing.T) {
+	words := []string{"kiwi", "fig", "apple", "pear", "plum", "date", "banana", "lime"}
+
+	// Sort by length only: words of equal length keep their input order
+	StableSortFunc(words, func(a, b string) bool { return len(a) < len(b) })
+
+	expected := []string{"fig", "kiwi", "pear", "plum", "date", "lime", "apple", "banana"}
+	if !reflect.DeepEqual(words, expected) {
+		t.Errorf("expected %v, got %v", expected, words)
+	}
+}
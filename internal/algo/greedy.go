@@ -0,0 +1,99 @@
+package algo
+
+import "github.com/farhancdr/backend-interview-handbook/internal/ds"
+
+// Why interviewers ask this:
+// Greedy algorithms make the locally optimal choice at each step. Jump Game problems
+// are the classic example: a DP solution works but is O(n²), while the greedy insight
+// brings it down to a single O(n) pass. Interviewers look for the proof sketch, not
+// just the code.
+
+// Common pitfalls:
+// - Reaching for DP/BFS when a single pass with a running "farthest" suffices
+// - Counting a jump for the last index (you only jump until you can reach it)
+// - Not detecting a zero that blocks all progress (infinite loop or wrong answer)
+// - Treating a single-element array as unreachable
+// - Sorting sticks once instead of re-selecting the two smallest after every merge
+
+// Key takeaway:
+// Track the farthest index reachable so far. CanJump fails as soon as i > farthest.
+// MinJumps treats [start, end] as the current "level" (BFS without a queue) and jumps
+// once per level, extending end to the farthest index seen inside it.
+// ConnectSticks always merges the two cheapest pieces, so the largest sticks are
+// added the fewest times - the same exchange argument behind Huffman coding.
+
+// CanJump reports whether the last index is reachable, where nums[i] is the
+// maximum jump length from index i
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func CanJump(nums []int) bool {
+	farthest := 0
+
+	for i := 0; i < len(nums); i++ {
+		if i > farthest {
+			return false // Stuck behind a zero
+		}
+		farthest = maxInt(farthest, i+nums[i])
+		if farthest >= len(nums)-1 {
+			return true
+		}
+	}
+
+	return len(nums) > 0
+}
+
+// MinJumps returns the minimum number of jumps needed to reach the last index
+// Returns 0 for a single element and -1 if the end is unreachable
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func MinJumps(nums []int) int {
+	if len(nums) <= 1 {
+		return 0
+	}
+
+	jumps := 0
+	currentEnd := 0 // Last index reachable with `jumps` jumps
+	farthest := 0   // Farthest index reachable with one more jump
+
+	for i := 0; i < len(nums)-1; i++ {
+		farthest = maxInt(farthest, i+nums[i])
+
+		if i == currentEnd {
+			if farthest <= i {
+				return -1 // Cannot move past i
+			}
+			jumps++
+			currentEnd = farthest
+			if currentEnd >= len(nums)-1 {
+				break
+			}
+		}
+	}
+
+	return jumps
+}
+
+// ConnectSticks returns the minimum total cost to combine all sticks into one,
+// where joining two sticks costs the sum of their lengths (optimal merge pattern)
+// Each merged stick goes back into the heap, so it may be chosen again next round
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+func ConnectSticks(sticks []int) int {
+	if len(sticks) < 2 {
+		return 0
+	}
+
+	h := ds.NewMinHeap()
+	h.BuildHeap(sticks)
+
+	cost := 0
+	for h.Size() > 1 {
+		first, _ := h.ExtractMin()
+		second, _ := h.ExtractMin()
+		merged := first + second
+		cost += merged
+		h.Insert(merged)
+	}
+
+	return cost
+}
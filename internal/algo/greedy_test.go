@@ -0,0 +1,86 @@
+package algo
+
+import "testing"
+
+func TestCanJump(t *testing.T) {
+	tests := []struct {
+		nums     []int
+		expected bool
+	}{
+		{[]int{2, 3, 1, 1, 4}, true},
+		{[]int{3, 2, 1, 0, 4}, false}, // Every path lands on the 0
+		{[]int{0}, true},              // Already at the end
+		{[]int{0, 1}, false},
+		{[]int{1, 0, 1}, false},
+		{[]int{2, 0, 0}, true},
+		{[]int{}, false},
+	}
+
+	for _, tt := range tests {
+		result := CanJump(tt.nums)
+		if result != tt.expected {
+			t.Errorf("CanJump(%v): expected %v, got %v", tt.nums, tt.expected, result)
+		}
+	}
+}
+
+func TestMinJumps(t *testing.T) {
+	tests := []struct {
+		nums     []int
+		expected int
+	}{
+		{[]int{2, 3, 1, 1, 4}, 2}, // 0 -> 1 -> 4
+		{[]int{2, 3, 0, 1, 4}, 2},
+		{[]int{1, 1, 1, 1}, 3},
+		{[]int{0}, 0},
+		{[]int{5, 0, 0, 0}, 1},
+		{[]int{3, 2, 1, 0, 4}, -1},
+		{[]int{0, 1}, -1},
+	}
+
+	for _, tt := range tests {
+		result := MinJumps(tt.nums)
+		if result != tt.expected {
+			t.Errorf("MinJumps(%v): expected %d, got %d", tt.nums, tt.expected, result)
+		}
+	}
+}
+
+func TestConnectSticks(t *testing.T) {
+	tests := []struct {
+		sticks   []int
+		expected int
+	}{
+		{[]int{2, 4, 3}, 14},    // 2+3=5, then 5+4=9
+		{[]int{1, 8, 3, 5}, 30}, // 1+3=4, 4+5=9, 9+8=17
+		{[]int{5}, 0},
+		{[]int{}, 0},
+		{[]int{4, 4}, 8},
+		{[]int{1, 1, 1, 1}, 8}, // Pairs first: 2+2, then 4
+	}
+
+	for _, tt := range tests {
+		result := ConnectSticks(tt.sticks)
+		if result != tt.expected {
+			t.Errorf("ConnectSticks(%v): expected %v, got %v", tt.sticks, tt.expected, result)
+		}
+	}
+}
+
+func TestConnectSticks_BeatsLeftToRight(t *testing.T) {
+	sticks := []int{1, 8, 3, 5}
+
+	// Merging in input order re-adds the long 8 stick in every later merge
+	naive, running := 0, sticks[0]
+	for _, s := range sticks[1:] {
+		running += s
+		naive += running
+	}
+
+	if result := ConnectSticks(sticks); result >= naive {
+		t.Errorf("expected greedy cost below left-to-right cost %d, got %d", naive, result)
+	}
+	if sticks[1] != 8 {
+		t.Errorf("ConnectSticks must not modify its input, got %v", sticks)
+	}
+}
@@ -0,0 +1,171 @@
+package algo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func toGrid(rows ...string) [][]byte {
+	grid := make([][]byte, len(rows))
+	for i, row := range rows {
+		grid[i] = []byte(row)
+	}
+	return grid
+}
+
+func TestNumIslands(t *testing.T) {
+	tests := []struct {
+		name     string
+		grid     [][]byte
+		expected int
+	}{
+		{"multiple islands", toGrid(
+			"11000",
+			"11000",
+			"00100",
+			"00011",
+		), 3},
+		{"single large island", toGrid(
+			"11110",
+			"11010",
+			"11000",
+			"00000",
+		), 1},
+		{"diagonal only", toGrid(
+			"101",
+			"010",
+			"101",
+		), 5},
+		{"all water", toGrid(
+			"000",
+			"000",
+		), 0},
+		{"empty grid", [][]byte{}, 0},
+	}
+
+	for _, tt := range tests {
+		result := NumIslands(tt.grid)
+		if result != tt.expected {
+			t.Errorf("NumIslands(%s): expected %d, got %d", tt.name, tt.expected, result)
+		}
+	}
+}
+
+func TestNumIslands_JaggedGrid(t *testing.T) {
+	tests := []struct {
+		name     string
+		grid     [][]byte
+		expected int
+	}{
+		{"shorter later row", toGrid("1111", "1", "011"), 2}, // (1,1) is missing, so row 2 is cut off
+		{"gap where row is short", toGrid("101", "1", "101"), 3},
+		{"empty first row", toGrid("", "11", "01"), 1},
+	}
+
+	for _, tt := range tests {
+		result := NumIslands(tt.grid)
+		if result != tt.expected {
+			t.Errorf("NumIslands(%s): expected %d, got %d", tt.name, tt.expected, result)
+		}
+	}
+}
+
+func TestNumIslands_DoesNotModifyGrid(t *testing.T) {
+	grid := toGrid("110", "011")
+	NumIslands(grid)
+
+	if !reflect.DeepEqual(grid, toGrid("110", "011")) {
+		t.Errorf("grid was modified: %q", grid)
+	}
+}
+
+func TestFloodFill(t *testing.T) {
+	image := [][]int{
+		{1, 1, 1},
+		{1, 1, 0},
+		{1, 0, 1},
+	}
+
+	result := FloodFill(image, 1, 1, 2)
+
+	// Bottom-right 1 is only diagonally connected, so it stays
+	expected := [][]int{
+		{2, 2, 2},
+		{2, 2, 0},
+		{2, 0, 1},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestFloodFill_SameColor(t *testing.T) {
+	image := [][]int{{0, 0, 0}, {0, 1, 1}}
+
+	result := FloodFill(image, 1, 1, 1)
+
+	expected := [][]int{{0, 0, 0}, {0, 1, 1}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestPacificAtlantic(t *testing.T) {
+	heights := [][]int{
+		{1, 2, 2, 3, 5},
+		{3, 2, 3, 4, 4},
+		{2, 4, 5, 3, 1},
+		{6, 7, 1, 4, 5},
+		{5, 1, 1, 2, 4},
+	}
+
+	result := PacificAtlantic(heights)
+	expected := [][]int{{0, 4}, {1, 3}, {1, 4}, {2, 2}, {3, 0}, {3, 1}, {4, 0}}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestPacificAtlantic_EdgeCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		heights  [][]int
+		expected [][]int
+	}{
+		{"single cell", [][]int{{7}}, [][]int{{0, 0}}},
+		{"flat grid", [][]int{{1, 1}, {1, 1}}, [][]int{{0, 0}, {0, 1}, {1, 0}, {1, 1}}},
+		{"empty", [][]int{}, [][]int{}},
+		{"empty row", [][]int{{}}, [][]int{}},
+		{"ragged", [][]int{{1, 2}, {3}}, [][]int{}},
+	}
+
+	for _, tt := range tests {
+		result := PacificAtlantic(tt.heights)
+		if !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, result)
+		}
+	}
+}
+
+func TestMinEffortPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		heights  [][]int
+		expected int
+	}{
+		{"detour beats direct", [][]int{{1, 2, 2}, {3, 8, 2}, {5, 3, 5}}, 2},
+		{"flat route exists", [][]int{{1, 2, 3}, {3, 8, 4}, {5, 3, 5}}, 1},
+		{"all equal", [][]int{{1, 2, 1, 1, 1}, {1, 2, 1, 2, 1}, {1, 2, 1, 2, 1}, {1, 2, 1, 2, 1}, {1, 1, 1, 2, 1}}, 0},
+		{"single cell", [][]int{{9}}, 0},
+		{"single row", [][]int{{1, 10, 6}}, 9},
+		{"empty", [][]int{}, 0},
+	}
+
+	for _, tt := range tests {
+		result := MinEffortPath(tt.heights)
+		if result != tt.expected {
+			t.Errorf("MinEffortPath(%s): expected %d, got %d", tt.name, tt.expected, result)
+		}
+	}
+}
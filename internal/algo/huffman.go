@@ -0,0 +1,163 @@
+package algo
+
+import (
+	"container/heap"
+	"sort"
+	"strings"
+)
+
+// Why interviewers ask this:
+// Huffman coding is the textbook greedy proof and ties together three data structures:
+// a frequency map, a min-heap to repeatedly pick the two rarest subtrees, and a binary
+// tree whose root-to-leaf paths become the codes. It is the same merge pattern as
+// ConnectSticks, with the tree kept around instead of just the total cost.
+
+// Common pitfalls:
+// - A single distinct character: the tree is one leaf, so its path is "" - give it "0"
+// - Non-deterministic tie-breaking producing different (equally optimal) codes per run
+// - Decoding by trying every code as a prefix instead of walking the tree bit by bit
+// - Iterating bytes instead of runes, splitting multi-byte characters
+
+// Key takeaway:
+// Merge the two lowest-frequency subtrees until one remains; left edges are 0, right
+// edges are 1. Frequent characters end up near the root with short codes, and since
+// characters only live at leaves no code is a prefix of another, so decoding is greedy.
+
+// Huffman encodes and decodes strings with an optimal prefix-free code
+// The zero value is ready to use
+type Huffman struct{}
+
+// huffmanNode is a leaf (a character) or an internal merge of two subtrees
+type huffmanNode struct {
+	char        rune
+	freq        int
+	order       int // Creation sequence, breaks frequency ties deterministically
+	left, right *huffmanNode
+}
+
+func (n *huffmanNode) isLeaf() bool { return n.left == nil && n.right == nil }
+
+// huffmanHeap is a min-heap of subtrees ordered by frequency
+// ds.MinHeap only stores ints, so the subtrees need their own container/heap
+type huffmanHeap []*huffmanNode
+
+func (h huffmanHeap) Len() int { return len(h) }
+func (h huffmanHeap) Less(i, j int) bool {
+	if h[i].freq != h[j].freq {
+		return h[i].freq < h[j].freq
+	}
+	return h[i].order < h[j].order
+}
+func (h huffmanHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *huffmanHeap) Push(x any)   { *h = append(*h, x.(*huffmanNode)) }
+
+func (h *huffmanHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+// Encode returns s as a string of '0'/'1' bits along with the code table used
+// Returns "" and an empty table for an empty string
+// Time Complexity: O(n + k log k) for n characters, k of them distinct
+// Space Complexity: O(n + k)
+func (Huffman) Encode(s string) (string, map[rune]string) {
+	codes := make(map[rune]string)
+	if s == "" {
+		return "", codes
+	}
+
+	freq := make(map[rune]int)
+	for _, r := range s {
+		freq[r]++
+	}
+
+	// Leaves in rune order so ties resolve the same way every run
+	chars := make([]rune, 0, len(freq))
+	for r := range freq {
+		chars = append(chars, r)
+	}
+	sort.Slice(chars, func(i, j int) bool { return chars[i] < chars[j] })
+
+	pq := make(huffmanHeap, 0, len(chars))
+	for i, r := range chars {
+		pq = append(pq, &huffmanNode{char: r, freq: freq[r], order: i})
+	}
+	heap.Init(&pq)
+
+	order := len(chars)
+	for pq.Len() > 1 {
+		left := heap.Pop(&pq).(*huffmanNode)
+		right := heap.Pop(&pq).(*huffmanNode)
+		heap.Push(&pq, &huffmanNode{freq: left.freq + right.freq, order: order, left: left, right: right})
+		order++
+	}
+
+	root := pq[0]
+	if root.isLeaf() {
+		codes[root.char] = "0" // Only one distinct character
+	} else {
+		assignCodes(root, "", codes)
+	}
+
+	var bits strings.Builder
+	for _, r := range s {
+		bits.WriteString(codes[r])
+	}
+
+	return bits.String(), codes
+}
+
+func assignCodes(node *huffmanNode, prefix string, codes map[rune]string) {
+	if node.isLeaf() {
+		codes[node.char] = prefix
+		return
+	}
+	assignCodes(node.left, prefix+"0", codes)
+	assignCodes(node.right, prefix+"1", codes)
+}
+
+// Decode rebuilds the code tree from codes and walks it bit by bit
+// Decoding stops at the first bit sequence that matches no code
+// Time Complexity: O(b + total code length) for b bits
+// Space Complexity: O(total code length)
+func (Huffman) Decode(bits string, codes map[rune]string) string {
+	root := &huffmanNode{}
+	for r, code := range codes {
+		node := root
+		for _, bit := range code {
+			if bit == '0' {
+				if node.left == nil {
+					node.left = &huffmanNode{}
+				}
+				node = node.left
+			} else {
+				if node.right == nil {
+					node.right = &huffmanNode{}
+				}
+				node = node.right
+			}
+		}
+		node.char = r
+	}
+
+	var result strings.Builder
+	node := root
+	for _, bit := range bits {
+		if bit == '0' {
+			node = node.left
+		} else {
+			node = node.right
+		}
+		if node == nil {
+			break // Not a valid code path
+		}
+		if node.isLeaf() {
+			result.WriteRune(node.char)
+			node = root
+		}
+	}
+
+	return result.String()
+}
@@ -0,0 +1,96 @@
+package algo
+
+import (
+	"math/bits"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestHuffman_RoundTrip(t *testing.T) {
+	tests := []string{
+		"abracadabra",
+		"the quick brown fox jumps over the lazy dog",
+		"aaaaabbbbcccdde",
+		"ab",
+		"héllo wörld ✓",
+	}
+
+	var h Huffman
+	for _, s := range tests {
+		encoded, codes := h.Encode(s)
+		if decoded := h.Decode(encoded, codes); decoded != s {
+			t.Errorf("Decode(Encode(%q)): expected %q, got %q", s, s, decoded)
+		}
+	}
+}
+
+func TestHuffman_NoWorseThanFixedWidth(t *testing.T) {
+	tests := []string{
+		"abracadabra",
+		"aaaaabbbbcccdde",
+		"abcdefgh", // Uniform frequencies: Huffman matches fixed width exactly
+		"mississippi river",
+	}
+
+	var h Huffman
+	for _, s := range tests {
+		encoded, codes := h.Encode(s)
+
+		// Fixed width needs ceil(log2(k)) bits per character for k distinct characters
+		width := bits.Len(uint(len(codes) - 1))
+		if width == 0 {
+			width = 1
+		}
+		fixed := utf8.RuneCountInString(s) * width
+
+		if len(encoded) > fixed {
+			t.Errorf("Encode(%q): expected at most %d bits, got %d", s, fixed, len(encoded))
+		}
+	}
+}
+
+func TestHuffman_CodesArePrefixFree(t *testing.T) {
+	var h Huffman
+	_, codes := h.Encode("aaaaabbbbcccdde")
+
+	for a, codeA := range codes {
+		for b, codeB := range codes {
+			if a != b && strings.HasPrefix(codeB, codeA) {
+				t.Errorf("code %q for %q is a prefix of %q for %q", codeA, string(a), codeB, string(b))
+			}
+		}
+	}
+
+	// The most frequent character never gets a longer code than the rarest
+	if len(codes['a']) > len(codes['e']) {
+		t.Errorf("expected code for 'a' no longer than for 'e', got %q and %q", codes['a'], codes['e'])
+	}
+}
+
+func TestHuffman_SingleCharacter(t *testing.T) {
+	var h Huffman
+	encoded, codes := h.Encode("zzzz")
+
+	if codes['z'] != "0" || len(codes) != 1 {
+		t.Errorf("expected single 1-bit code, got %v", codes)
+	}
+	if encoded != "0000" {
+		t.Errorf("expected %q, got %q", "0000", encoded)
+	}
+	if decoded := h.Decode(encoded, codes); decoded != "zzzz" {
+		t.Errorf("expected %q, got %q", "zzzz", decoded)
+	}
+}
+
+func TestHuffman_Empty(t *testing.T) {
+	var h Huffman
+	encoded, codes := h.Encode("")
+
+	if encoded != "" || len(codes) != 0 {
+		t.Errorf("expected empty encoding, got %q %v", encoded, codes)
+	}
+	if decoded := h.Decode("", codes); decoded != "" {
+		t.Errorf("expected empty decode, got %q", decoded)
+	}
+}
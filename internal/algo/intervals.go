@@ -0,0 +1,72 @@
+package algo
+
+import "sort"
+
+// Why interviewers ask this:
+// Interval problems (merge intervals, meeting rooms, free time) model calendars, booking
+// systems, and resource reservations. They test sorting as a preprocessing step and
+// careful handling of overlapping vs touching boundaries.
+
+// Common pitfalls:
+// - Forgetting to sort by start time before merging
+// - Only comparing with the previous input interval instead of the last merged one
+// - Treating touching intervals ([1,3] and [3,5]) as having a gap between them
+// - Mutating the caller's intervals while merging
+
+// Key takeaway:
+// Sort by start, then sweep: if the next interval starts at or before the current end,
+// extend the end; otherwise close the current interval. Free time is simply the gaps
+// between consecutive merged busy intervals.
+
+// MergeIntervals merges all overlapping or touching [start, end] intervals
+// The input is not modified
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+func MergeIntervals(intervals [][]int) [][]int {
+	merged := [][]int{}
+	if len(intervals) == 0 {
+		return merged
+	}
+
+	sorted := make([][]int, len(intervals))
+	copy(sorted, intervals)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i][0] < sorted[j][0]
+	})
+
+	current := []int{sorted[0][0], sorted[0][1]}
+	for _, interval := range sorted[1:] {
+		if interval[0] <= current[1] {
+			// Overlapping or touching: extend the current interval
+			current[1] = maxInt(current[1], interval[1])
+		} else {
+			merged = append(merged, current)
+			current = []int{interval[0], interval[1]}
+		}
+	}
+	merged = append(merged, current)
+
+	return merged
+}
+
+// EmployeeFreeTime returns the finite intervals during which every employee is free,
+// given each employee's busy [start, end] intervals
+// Example: [[[1,2],[5,6]], [[1,3]], [[4,10]]] -> [[3,4]]
+// Time Complexity: O(n log n) where n is the total number of intervals
+// Space Complexity: O(n)
+func EmployeeFreeTime(schedules [][][]int) [][]int {
+	// Flatten: who is busy doesn't matter, only when someone is busy
+	all := [][]int{}
+	for _, schedule := range schedules {
+		all = append(all, schedule...)
+	}
+
+	busy := MergeIntervals(all)
+
+	free := [][]int{}
+	for i := 1; i < len(busy); i++ {
+		free = append(free, []int{busy[i-1][1], busy[i][0]})
+	}
+
+	return free
+}
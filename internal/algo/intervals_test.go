@@ -0,0 +1,82 @@
+package algo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeIntervals(t *testing.T) {
+	tests := []struct {
+		intervals [][]int
+		expected  [][]int
+	}{
+		{[][]int{{1, 3}, {2, 6}, {8, 10}, {15, 18}}, [][]int{{1, 6}, {8, 10}, {15, 18}}},
+		{[][]int{{1, 4}, {4, 5}}, [][]int{{1, 5}}},         // Touching
+		{[][]int{{5, 6}, {1, 2}}, [][]int{{1, 2}, {5, 6}}}, // Unsorted input
+		{[][]int{{1, 10}, {2, 3}}, [][]int{{1, 10}}},       // Contained
+		{[][]int{}, [][]int{}},
+	}
+
+	for _, tt := range tests {
+		result := MergeIntervals(tt.intervals)
+		if !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("MergeIntervals(%v): expected %v, got %v", tt.intervals, tt.expected, result)
+		}
+	}
+}
+
+func TestMergeIntervals_DoesNotModifyInput(t *testing.T) {
+	intervals := [][]int{{5, 6}, {1, 3}, {2, 4}}
+	MergeIntervals(intervals)
+
+	expected := [][]int{{5, 6}, {1, 3}, {2, 4}}
+	if !reflect.DeepEqual(intervals, expected) {
+		t.Errorf("input was modified: %v", intervals)
+	}
+}
+
+func TestEmployeeFreeTime(t *testing.T) {
+	tests := []struct {
+		name      string
+		schedules [][][]int
+		expected  [][]int
+	}{
+		{
+			"standard example 1",
+			[][][]int{{{1, 2}, {5, 6}}, {{1, 3}}, {{4, 10}}},
+			[][]int{{3, 4}},
+		},
+		{
+			"standard example 2",
+			[][][]int{{{1, 3}, {6, 7}}, {{2, 4}}, {{2, 5}, {9, 12}}},
+			[][]int{{5, 6}, {7, 9}},
+		},
+		{
+			"no overlap between employees",
+			[][][]int{{{1, 2}}, {{3, 4}}, {{5, 6}}},
+			[][]int{{2, 3}, {4, 5}},
+		},
+		{
+			"fully overlapping busy times",
+			[][][]int{{{1, 10}}, {{2, 5}}, {{3, 9}}},
+			[][]int{},
+		},
+		{
+			"touching intervals leave no gap",
+			[][][]int{{{1, 3}}, {{3, 6}}},
+			[][]int{},
+		},
+		{
+			"no schedules",
+			[][][]int{},
+			[][]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		result := EmployeeFreeTime(tt.schedules)
+		if !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("EmployeeFreeTime(%s): expected %v, got %v", tt.name, tt.expected, result)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package algo
+
+import "sort"
+
+// Why interviewers ask this:
+// Minimum spanning trees model "connect everything as cheaply as possible": laying cable,
+// clustering, network design. Kruskal's algorithm is a textbook greedy algorithm whose
+// correctness hinges on the cut property, and it is the canonical use of Union-Find.
+
+// Common pitfalls:
+// - Checking for cycles with DFS on every edge (O(E * V)) instead of Union-Find
+// - Forgetting to sort edges by weight first
+// - Assuming the graph is connected; a disconnected graph has a spanning forest
+// - Stopping early without noticing fewer than n-1 edges were chosen
+
+// Key takeaway:
+// Sort edges by weight and take each edge whose endpoints are in different components
+// (Union returns true). The graph is connected iff exactly n-1 edges were taken.
+// Time: O(E log E) for sorting; Union-Find makes the cycle checks nearly free.
+
+// Kruskal computes a minimum spanning tree (or forest) of an undirected graph with
+// vertices 0..n-1 and edges given as [u, v, weight]. connected is false when the
+// graph is disconnected, in which case the result is a minimum spanning forest.
+// Time Complexity: O(E log E)
+// Space Complexity: O(V + E)
+func Kruskal(n int, edges [][]int) (mstWeight int, mstEdges [][]int, connected bool) {
+	sorted := make([][]int, len(edges))
+	copy(sorted, edges)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i][2] < sorted[j][2]
+	})
+
+	uf := NewUnionFind(n)
+	mstEdges = [][]int{}
+
+	for _, edge := range sorted {
+		// Greedy: cheapest edge that doesn't form a cycle
+		if uf.Union(edge[0], edge[1]) {
+			mstWeight += edge[2]
+			mstEdges = append(mstEdges, edge)
+			if len(mstEdges) == n-1 {
+				break
+			}
+		}
+	}
+
+	return mstWeight, mstEdges, uf.Count() <= 1
+}
@@ -0,0 +1,65 @@
+package algo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKruskal(t *testing.T) {
+	// 0 --1-- 1
+	// |     / |
+	// 4   2   3
+	// | /     |
+	// 2 --5-- 3
+	edges := [][]int{
+		{0, 1, 1},
+		{0, 2, 4},
+		{1, 2, 2},
+		{1, 3, 3},
+		{2, 3, 5},
+	}
+
+	weight, mst, connected := Kruskal(4, edges)
+
+	if !connected {
+		t.Error("expected connected graph")
+	}
+	if weight != 6 {
+		t.Errorf("expected MST weight 6, got %d", weight)
+	}
+
+	expected := [][]int{{0, 1, 1}, {1, 2, 2}, {1, 3, 3}}
+	if !reflect.DeepEqual(mst, expected) {
+		t.Errorf("expected edges %v, got %v", expected, mst)
+	}
+}
+
+func TestKruskal_Disconnected(t *testing.T) {
+	// Two components: {0,1,2} and {3,4}
+	edges := [][]int{
+		{0, 1, 2},
+		{1, 2, 3},
+		{0, 2, 1},
+		{3, 4, 7},
+	}
+
+	weight, mst, connected := Kruskal(5, edges)
+
+	if connected {
+		t.Error("expected disconnected graph")
+	}
+	if weight != 10 { // 1 + 2 + 7
+		t.Errorf("expected forest weight 10, got %d", weight)
+	}
+	if len(mst) != 3 {
+		t.Errorf("expected 3 forest edges, got %d", len(mst))
+	}
+}
+
+func TestKruskal_SingleVertex(t *testing.T) {
+	weight, mst, connected := Kruskal(1, [][]int{})
+
+	if !connected || weight != 0 || len(mst) != 0 {
+		t.Errorf("expected trivial MST, got weight=%d edges=%v connected=%v", weight, mst, connected)
+	}
+}
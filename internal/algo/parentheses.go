@@ -0,0 +1,80 @@
+package algo
+
+// Why interviewers ask this:
+// Parentheses problems come in two flavours: checking a string (stack) and producing
+// strings (backtracking). Generating all well-formed combinations is the canonical
+// "backtracking with pruning" question - the constraints tell you exactly which
+// branches are worth exploring.
+
+// Common pitfalls:
+// - Generating all 2^(2n) strings and filtering afterwards (exponentially wasteful)
+// - Allowing ')' when close >= open, which produces invalid prefixes
+// - Forgetting n=0 has exactly one answer: the empty string
+// - Checking balance with a single counter when several bracket types are mixed
+
+// Key takeaway:
+// Backtrack with two counters: add '(' while open < n, add ')' while close < open.
+// Every leaf is then valid by construction, and there are Catalan(n) of them.
+// For validation, push openers on a stack and pop on each matching closer.
+
+// GenerateParentheses returns all combinations of n well-formed pairs of parentheses
+// Time Complexity: O(4^n / sqrt(n)) - the nth Catalan number of results, each of length 2n
+// Space Complexity: O(n) recursion depth, excluding the output
+func GenerateParentheses(n int) []string {
+	result := []string{}
+	if n < 0 {
+		return result
+	}
+
+	buf := make([]byte, 0, 2*n)
+
+	var backtrack func(open, close int)
+	backtrack = func(open, close int) {
+		if len(buf) == 2*n {
+			result = append(result, string(buf))
+			return
+		}
+
+		if open < n {
+			buf = append(buf, '(')
+			backtrack(open+1, close)
+			buf = buf[:len(buf)-1]
+		}
+
+		if close < open {
+			buf = append(buf, ')')
+			backtrack(open, close+1)
+			buf = buf[:len(buf)-1]
+		}
+	}
+
+	backtrack(0, 0)
+	return result
+}
+
+// IsBalanced reports whether every bracket in s - (), [] or {} - is closed in the right order
+// Other characters are ignored
+// Time Complexity: O(n)
+// Space Complexity: O(n) for the stack
+func IsBalanced(s string) bool {
+	pairs := map[rune]rune{
+		')': '(',
+		']': '[',
+		'}': '{',
+	}
+
+	stack := []rune{}
+	for _, char := range s {
+		switch char {
+		case '(', '[', '{':
+			stack = append(stack, char)
+		case ')', ']', '}':
+			if len(stack) == 0 || stack[len(stack)-1] != pairs[char] {
+				return false
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	return len(stack) == 0
+}
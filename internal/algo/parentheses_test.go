@@ -0,0 +1,74 @@
+package algo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGenerateParentheses(t *testing.T) {
+	result := GenerateParentheses(3)
+	expected := []string{"((()))", "(()())", "(())()", "()(())", "()()()"}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("GenerateParentheses(3): expected %v, got %v", expected, result)
+	}
+
+	for _, s := range result {
+		if !IsBalanced(s) {
+			t.Errorf("GenerateParentheses(3) produced unbalanced %q", s)
+		}
+	}
+}
+
+func TestGenerateParentheses_CatalanCounts(t *testing.T) {
+	catalan := []int{1, 1, 2, 5, 14, 42, 132}
+
+	for n, expected := range catalan {
+		result := GenerateParentheses(n)
+		if len(result) != expected {
+			t.Errorf("GenerateParentheses(%d): expected %d combinations, got %d", n, expected, len(result))
+		}
+
+		seen := make(map[string]bool)
+		for _, s := range result {
+			if len(s) != 2*n || !IsBalanced(s) || seen[s] {
+				t.Errorf("GenerateParentheses(%d): invalid or duplicate %q", n, s)
+			}
+			seen[s] = true
+		}
+	}
+}
+
+func TestGenerateParentheses_Zero(t *testing.T) {
+	result := GenerateParentheses(0)
+	expected := []string{""}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("GenerateParentheses(0): expected %q, got %q", expected, result)
+	}
+}
+
+func TestIsBalanced(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected bool
+	}{
+		{"", true},
+		{"()", true},
+		{"()[]{}", true},
+		{"{[()]}", true},
+		{"(a+b)*[c]", true},
+		{"(]", false},
+		{"([)]", false},
+		{"((", false},
+		{"())", false},
+		{")(", false},
+	}
+
+	for _, tt := range tests {
+		result := IsBalanced(tt.s)
+		if result != tt.expected {
+			t.Errorf("IsBalanced(%q): expected %v, got %v", tt.s, tt.expected, result)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package algo
+
+// Why interviewers ask this:
+// The Sieve of Eratosthenes is the classic example of trading memory for time: instead of
+// testing each number for primality (O(n√n)), it crosses off multiples and runs in
+// O(n log log n). It comes up directly ("count primes") and as a building block.
+
+// Common pitfalls:
+// - Trial-dividing every number instead of sieving
+// - Starting to cross off at 2*p instead of p*p (correct but wasteful)
+// - Off-by-one: "primes less than n" excludes n itself
+// - Forgetting that 0 and 1 are not prime
+
+// Key takeaway:
+// Mark every number as prime, then for each p with p*p < n still marked, cross off
+// p*p, p*p+p, ... Smaller multiples were already crossed off by smaller primes.
+
+// sieve returns isComposite for 0..n-1; indexes 0 and 1 are marked composite
+func sieve(n int) []bool {
+	isComposite := make([]bool, n)
+	if n > 0 {
+		isComposite[0] = true
+	}
+	if n > 1 {
+		isComposite[1] = true
+	}
+
+	for p := 2; p*p < n; p++ {
+		if isComposite[p] {
+			continue
+		}
+		for multiple := p * p; multiple < n; multiple += p {
+			isComposite[multiple] = true
+		}
+	}
+
+	return isComposite
+}
+
+// CountPrimes returns the number of primes strictly less than n
+// Time Complexity: O(n log log n)
+// Space Complexity: O(n)
+func CountPrimes(n int) int {
+	if n <= 2 {
+		return 0
+	}
+
+	count := 0
+	for _, composite := range sieve(n) {
+		if !composite {
+			count++
+		}
+	}
+	return count
+}
+
+// SievePrimes returns all primes strictly less than n in ascending order
+// Time Complexity: O(n log log n)
+// Space Complexity: O(n)
+func SievePrimes(n int) []int {
+	primes := []int{}
+	if n <= 2 {
+		return primes
+	}
+
+	for i, composite := range sieve(n) {
+		if !composite {
+			primes = append(primes, i)
+		}
+	}
+	return primes
+}
@@ -0,0 +1,46 @@
+package algo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCountPrimes(t *testing.T) {
+	tests := []struct {
+		n        int
+		expected int
+	}{
+		{10, 4}, // 2, 3, 5, 7
+		{0, 0},
+		{1, 0},
+		{2, 0}, // "less than 2"
+		{3, 1},
+		{100, 25},
+		{1000000, 78498},
+	}
+
+	for _, tt := range tests {
+		result := CountPrimes(tt.n)
+		if result != tt.expected {
+			t.Errorf("CountPrimes(%d): expected %d, got %d", tt.n, tt.expected, result)
+		}
+	}
+}
+
+func TestSievePrimes(t *testing.T) {
+	result := SievePrimes(20)
+
+	expected := []int{2, 3, 5, 7, 11, 13, 17, 19}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestSievePrimes_Small(t *testing.T) {
+	if len(SievePrimes(2)) != 0 {
+		t.Error("expected no primes below 2")
+	}
+	if !reflect.DeepEqual(SievePrimes(3), []int{2}) {
+		t.Errorf("expected [2], got %v", SievePrimes(3))
+	}
+}
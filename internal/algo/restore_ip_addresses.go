@@ -0,0 +1,80 @@
+package algo
+
+import "strings"
+
+// Why interviewers ask this:
+// Restore IP Addresses is backtracking where pruning does most of the work: the search
+// space is tiny once you reject impossible branches early. It also tests careful string
+// validation - leading zeros, the 255 limit, and exact-length accounting.
+
+// Common pitfalls:
+// - Accepting octets with leading zeros like "01" or "00"
+// - Comparing octets as strings ("99" > "255") instead of numbers
+// - Not checking the remaining length, so the search explores hopeless branches
+// - Forgetting inputs shorter than 4 or longer than 12 digits can never form an address
+
+// Key takeaway:
+// Choose each octet's length (1-3 digits), validate it, recurse on the rest. Prune when the
+// remaining digits can't fill the remaining octets (need between 1 and 3 digits each).
+// At most 3^4 = 81 leaves, so the work is effectively constant.
+
+// RestoreIPAddresses returns every valid IPv4 address formed by inserting three dots into s
+// Time Complexity: O(1) - at most 3^4 candidate splits of at most 12 digits
+// Space Complexity: O(1) excluding the output
+func RestoreIPAddresses(s string) []string {
+	result := []string{}
+	if len(s) < 4 || len(s) > 12 {
+		return result
+	}
+
+	octets := make([]string, 0, 4)
+
+	var backtrack func(start int)
+	backtrack = func(start int) {
+		remainingOctets := 4 - len(octets)
+		remainingDigits := len(s) - start
+
+		if remainingOctets == 0 {
+			if remainingDigits == 0 {
+				result = append(result, strings.Join(octets, "."))
+			}
+			return
+		}
+
+		// Prune: every remaining octet needs 1 to 3 digits
+		if remainingDigits < remainingOctets || remainingDigits > 3*remainingOctets {
+			return
+		}
+
+		for length := 1; length <= 3 && start+length <= len(s); length++ {
+			octet := s[start : start+length]
+			if !isValidOctet(octet) {
+				continue
+			}
+
+			octets = append(octets, octet)
+			backtrack(start + length)
+			octets = octets[:len(octets)-1]
+		}
+	}
+
+	backtrack(0)
+	return result
+}
+
+// isValidOctet reports whether octet is a decimal number 0-255 without leading zeros
+func isValidOctet(octet string) bool {
+	if len(octet) > 1 && octet[0] == '0' {
+		return false
+	}
+
+	value := 0
+	for i := 0; i < len(octet); i++ {
+		if octet[i] < '0' || octet[i] > '9' {
+			return false
+		}
+		value = value*10 + int(octet[i]-'0')
+	}
+
+	return value <= 255
+}
@@ -0,0 +1,43 @@
+package algo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRestoreIPAddresses(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected []string
+	}{
+		{"25525511135", []string{"255.255.11.135", "255.255.111.35"}},
+		{"0000", []string{"0.0.0.0"}},
+		{"101023", []string{"1.0.10.23", "1.0.102.3", "10.1.0.23", "10.10.2.3", "101.0.2.3"}},
+		{"1111", []string{"1.1.1.1"}},
+		{"256256256256", []string{}}, // Every octet over 255
+		{"010010", []string{"0.10.0.10", "0.100.1.0"}},
+	}
+
+	for _, tt := range tests {
+		result := RestoreIPAddresses(tt.s)
+		if !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("RestoreIPAddresses(%q): expected %v, got %v", tt.s, tt.expected, result)
+		}
+	}
+}
+
+func TestRestoreIPAddresses_InvalidLengths(t *testing.T) {
+	for _, s := range []string{"", "1", "123", "1234567890123"} {
+		result := RestoreIPAddresses(s)
+		if len(result) != 0 {
+			t.Errorf("RestoreIPAddresses(%q): expected no addresses, got %v", s, result)
+		}
+	}
+}
+
+func TestRestoreIPAddresses_NonDigits(t *testing.T) {
+	result := RestoreIPAddresses("1a11")
+	if len(result) != 0 {
+		t.Errorf("expected no addresses for non-digit input, got %v", result)
+	}
+}
@@ -229,6 +229,44 @@ func CharacterReplacement(s string, k int) int {
 	return maxLen
 }
 
+// LongestSubstringAtLeastK finds longest substring where every character appears at least k times
+// Divide and conquer: a character occurring fewer than k times in the whole string can never
+// be part of a valid substring, so split around it and solve each piece independently.
+// Time Complexity: O(n * d) where d is the number of distinct characters (each level removes one)
+// Space Complexity: O(d) per level for counts, recursion depth at most d
+func LongestSubstringAtLeastK(s string, k int) int {
+	if len(s) < k {
+		return 0
+	}
+	if k <= 1 {
+		return len(s)
+	}
+
+	charCount := make(map[byte]int)
+	for i := 0; i < len(s); i++ {
+		charCount[s[i]]++
+	}
+
+	maxLen := 0
+	start := 0
+	split := false
+
+	for i := 0; i < len(s); i++ {
+		if charCount[s[i]] < k {
+			// s[i] is a "rare" character: it splits the string
+			split = true
+			maxLen = maxInt(maxLen, LongestSubstringAtLeastK(s[start:i], k))
+			start = i + 1
+		}
+	}
+
+	if !split {
+		return len(s) // Every character already appears at least k times
+	}
+
+	return maxInt(maxLen, LongestSubstringAtLeastK(s[start:], k))
+}
+
 // Helper function
 func mapsEqual(m1, m2 map[byte]int) bool {
 	if len(m1) != len(m2) {
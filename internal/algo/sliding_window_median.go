@@ -0,0 +1,138 @@
+package algo
+
+import "container/heap"
+
+// Why interviewers ask this:
+// Sliding window median combines two classic ideas: the two-heap running median and the
+// sliding window. The twist is removal - heaps can't delete arbitrary elements cheaply -
+// so it tests whether you know the lazy-deletion trick and can keep the heaps balanced
+// by logical size rather than physical length.
+
+// Common pitfalls:
+// - Re-sorting every window: O(n * k log k)
+// - Searching the heap linearly to remove the outgoing element: O(k) per step
+// - Balancing by heap.Len() when heaps still contain lazily deleted elements
+// - Overflow when averaging two large ints for even k (convert to float64 first)
+
+// Key takeaway:
+// Keep a max-heap of the lower half and a min-heap of the upper half, with the lower half
+// holding the extra element for odd k. When an element leaves the window, record it in a
+// "delayed" map and adjust the logical size of its half; physically pop it only once it
+// surfaces at a heap top. Each element is pushed and popped once, so each step is O(log k).
+
+// intHeap is a binary heap of ints ordered by less
+type intHeap struct {
+	data []int
+	less func(a, b int) bool
+}
+
+func (h *intHeap) Len() int           { return len(h.data) }
+func (h *intHeap) Less(i, j int) bool { return h.less(h.data[i], h.data[j]) }
+func (h *intHeap) Swap(i, j int)      { h.data[i], h.data[j] = h.data[j], h.data[i] }
+func (h *intHeap) Push(x any)         { h.data = append(h.data, x.(int)) }
+func (h *intHeap) top() int           { return h.data[0] }
+
+func (h *intHeap) Pop() any {
+	last := h.data[len(h.data)-1]
+	h.data = h.data[:len(h.data)-1]
+	return last
+}
+
+// windowMedian maintains the median of a multiset under inserts and deletes
+type windowMedian struct {
+	low      *intHeap // Max-heap: lower half, holds the extra element for odd sizes
+	high     *intHeap // Min-heap: upper half
+	lowSize  int      // Logical sizes, excluding lazily deleted elements
+	highSize int
+	delayed  map[int]int // Value -> number of pending deletions
+}
+
+func newWindowMedian() *windowMedian {
+	return &windowMedian{
+		low:     &intHeap{less: func(a, b int) bool { return a > b }},
+		high:    &intHeap{less: func(a, b int) bool { return a < b }},
+		delayed: make(map[int]int),
+	}
+}
+
+func (w *windowMedian) add(num int) {
+	if w.low.Len() == 0 || num <= w.low.top() {
+		heap.Push(w.low, num)
+		w.lowSize++
+	} else {
+		heap.Push(w.high, num)
+		w.highSize++
+	}
+	w.balance()
+}
+
+func (w *windowMedian) remove(num int) {
+	w.delayed[num]++
+
+	if num <= w.low.top() {
+		w.lowSize--
+		if num == w.low.top() {
+			w.prune(w.low)
+		}
+	} else {
+		w.highSize--
+		if num == w.high.top() {
+			w.prune(w.high)
+		}
+	}
+	w.balance()
+}
+
+// balance keeps lowSize == highSize or lowSize == highSize+1
+func (w *windowMedian) balance() {
+	if w.lowSize > w.highSize+1 {
+		heap.Push(w.high, heap.Pop(w.low))
+		w.lowSize--
+		w.highSize++
+		w.prune(w.low)
+	} else if w.lowSize < w.highSize {
+		heap.Push(w.low, heap.Pop(w.high))
+		w.highSize--
+		w.lowSize++
+		w.prune(w.high)
+	}
+}
+
+// prune pops lazily deleted elements off the top of h
+func (w *windowMedian) prune(h *intHeap) {
+	for h.Len() > 0 && w.delayed[h.top()] > 0 {
+		w.delayed[h.top()]--
+		heap.Pop(h)
+	}
+}
+
+func (w *windowMedian) median() float64 {
+	if w.lowSize > w.highSize {
+		return float64(w.low.top())
+	}
+	return (float64(w.low.top()) + float64(w.high.top())) / 2
+}
+
+// MedianSlidingWindow returns the median of every window of k consecutive elements
+// Time Complexity: O(n log n) - each element is pushed and popped at most once
+// Space Complexity: O(n) worst case, since lazily deleted elements may linger in the heaps
+func MedianSlidingWindow(nums []int, k int) []float64 {
+	result := []float64{}
+	if k <= 0 || len(nums) < k {
+		return result
+	}
+
+	w := newWindowMedian()
+	for i := 0; i < k; i++ {
+		w.add(nums[i])
+	}
+	result = append(result, w.median())
+
+	for i := k; i < len(nums); i++ {
+		w.add(nums[i])
+		w.remove(nums[i-k])
+		result = append(result, w.median())
+	}
+
+	return result
+}
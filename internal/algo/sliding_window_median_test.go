@@ -0,0 +1,64 @@
+package algo
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// bruteForceMedians sorts every window independently
+func bruteForceMedians(nums []int, k int) []float64 {
+	result := []float64{}
+	for i := 0; i+k <= len(nums); i++ {
+		window := append([]int(nil), nums[i:i+k]...)
+		sort.Ints(window)
+		if k%2 == 1 {
+			result = append(result, float64(window[k/2]))
+		} else {
+			result = append(result, (float64(window[k/2-1])+float64(window[k/2]))/2)
+		}
+	}
+	return result
+}
+
+func TestMedianSlidingWindow(t *testing.T) {
+	tests := []struct {
+		nums     []int
+		k        int
+		expected []float64
+	}{
+		{[]int{1, 3, -1, -3, 5, 3, 6, 7}, 3, []float64{1, -1, -1, 3, 5, 6}},
+		{[]int{1, 2, 3, 4, 2, 3, 1, 4, 2}, 3, []float64{2, 3, 3, 3, 2, 3, 2}},
+		{[]int{1, 4, 2, 3}, 4, []float64{2.5}},
+		{[]int{5, 5, 5}, 2, []float64{5, 5}},
+		{[]int{7}, 1, []float64{7}},
+		{[]int{1, 2}, 3, []float64{}},
+	}
+
+	for _, tt := range tests {
+		result := MedianSlidingWindow(tt.nums, tt.k)
+		if !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("MedianSlidingWindow(%v, %d): expected %v, got %v", tt.nums, tt.k, tt.expected, result)
+		}
+	}
+}
+
+func TestMedianSlidingWindow_MatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+
+	for trial := 0; trial < 200; trial++ {
+		n := 1 + rng.Intn(40)
+		k := 1 + rng.Intn(n) // Covers both odd and even k
+		nums := make([]int, n)
+		for i := range nums {
+			nums[i] = rng.Intn(21) - 10 // Small range forces duplicates
+		}
+
+		result := MedianSlidingWindow(nums, k)
+		expected := bruteForceMedians(nums, k)
+		if !reflect.DeepEqual(result, expected) {
+			t.Fatalf("MedianSlidingWindow(%v, %d): expected %v, got %v", nums, k, expected, result)
+		}
+	}
+}
@@ -172,3 +172,27 @@ func TestSlidingWindow_EdgeCases(t *testing.T) {
 		t.Errorf("expected -3, got %d", result)
 	}
 }
+
+func TestLongestSubstringAtLeastK(t *testing.T) {
+	tests := []struct {
+		s        string
+		k        int
+		expected int
+	}{
+		{"aaabb", 3, 3},    // "aaa"
+		{"ababbc", 2, 5},   // "ababb"
+		{"ababacb", 3, 0},  // no valid substring
+		{"bbaaacbd", 3, 3}, // "aaa"
+		{"abc", 1, 3},      // k=1: whole string
+		{"", 2, 0},
+		{"aa", 3, 0},
+	}
+
+	for _, tt := range tests {
+		result := LongestSubstringAtLeastK(tt.s, tt.k)
+		if result != tt.expected {
+			t.Errorf("LongestSubstringAtLeastK(%s, %d): expected %d, got %d",
+				tt.s, tt.k, tt.expected, result)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package algo
 
+import "cmp"
+
 // Why interviewers ask this:
 // Sorting algorithms test understanding of time/space complexity, recursion,
 // divide-and-conquer, and in-place operations. Knowing when to use which
@@ -14,8 +16,10 @@ package algo
 
 // Key takeaway:
 // QuickSort: O(n log n) average, O(n²) worst, in-place, unstable
+// 3-way QuickSort: same, but runs of equal keys cost O(n) instead of O(n²)
 // MergeSort: O(n log n) always, O(n) space, stable
 // Choose based on requirements: stability, space, worst-case guarantees
+// Counting/Radix sort skip comparisons entirely: O(n+k) and O(n*bytes) for integers
 
 // QuickSort sorts array in-place using divide-and-conquer
 // Time Complexity: O(n log n) average, O(n²) worst
@@ -24,27 +28,29 @@ func QuickSort(arr []int) {
 	if len(arr) <= 1 {
 		return
 	}
-	quickSortHelper(arr, 0, len(arr)-1)
+	quickSortHelper(arr, 0, len(arr)-1, cmp.Compare[int])
 }
 
-func quickSortHelper(arr []int, low, high int) {
+func quickSortHelper(arr []int, low, high int, compare func(a, b int) int) {
 	if low < high {
 		// Partition and get pivot index
-		pivotIndex := partition(arr, low, high)
+		pivotIndex := partition(arr, low, high, compare)
 
 		// Recursively sort left and right
-		quickSortHelper(arr, low, pivotIndex-1)
-		quickSortHelper(arr, pivotIndex+1, high)
+		quickSortHelper(arr, low, pivotIndex-1, compare)
+		quickSortHelper(arr, pivotIndex+1, high, compare)
 	}
 }
 
-func partition(arr []int, low, high int) int {
+// partition is Lomuto's partition around the last element
+// compare is injected so tests can count comparisons
+func partition(arr []int, low, high int, compare func(a, b int) int) int {
 	// Choose last element as pivot
 	pivot := arr[high]
 	i := low - 1
 
 	for j := low; j < high; j++ {
-		if arr[j] <= pivot {
+		if compare(arr[j], pivot) <= 0 {
 			i++
 			arr[i], arr[j] = arr[j], arr[i]
 		}
@@ -55,6 +61,58 @@ func partition(arr []int, low, high int) int {
 	return i + 1
 }
 
+// QuickSort3Way sorts array in-place, partitioning into < pivot, == pivot and > pivot regions
+// The 2-way partition above sends every element equal to the pivot to one side, so an
+// all-equal array recurses n times. Here the equal block is final after one pass.
+// Time Complexity: O(n log n) average, O(n * d) for d distinct values (O(n) if all equal)
+// Space Complexity: O(log n) for recursion stack, by recursing only into the smaller side
+func QuickSort3Way(arr []int) {
+	if len(arr) <= 1 {
+		return
+	}
+	quickSort3WayHelper(arr, 0, len(arr)-1, cmp.Compare[int])
+}
+
+func quickSort3WayHelper(arr []int, low, high int, compare func(a, b int) int) {
+	for low < high {
+		lt, gt := partition3Way(arr, low, high, compare)
+
+		// arr[lt..gt] all equal the pivot and are already in their final place.
+		// Recurse into the smaller side and loop on the larger to keep the stack O(log n)
+		if lt-low < high-gt {
+			quickSort3WayHelper(arr, low, lt-1, compare)
+			low = gt + 1
+		} else {
+			quickSort3WayHelper(arr, gt+1, high, compare)
+			high = lt - 1
+		}
+	}
+}
+
+// partition3Way is Dijkstra's Dutch national flag partition around the middle element
+// compare is injected so tests can count comparisons
+// Returns lt, gt such that arr[low:lt] < pivot, arr[lt:gt+1] == pivot, arr[gt+1:high+1] > pivot
+func partition3Way(arr []int, low, high int, compare func(a, b int) int) (int, int) {
+	pivot := arr[low+(high-low)/2] // Middle pivot avoids the sorted-input worst case
+	lt, i, gt := low, low, high
+
+	for i <= gt {
+		switch c := compare(arr[i], pivot); {
+		case c < 0:
+			arr[lt], arr[i] = arr[i], arr[lt]
+			lt++
+			i++
+		case c > 0:
+			arr[i], arr[gt] = arr[gt], arr[i]
+			gt-- // Don't advance i: the swapped-in element hasn't been examined
+		default:
+			i++
+		}
+	}
+
+	return lt, gt
+}
+
 // MergeSort sorts array using divide-and-conquer (stable sort)
 // Time Complexity: O(n log n)
 // Space Complexity: O(n)
@@ -92,6 +150,15 @@ func merge(left, right []int) []int {
 	return result
 }
 
+// MergeSortInPlace sorts arr in place and stably, unlike MergeSort which returns a new slice
+// It still needs scratch space, but only one n/2 buffer reused by every merge; merging with
+// no buffer at all is possible but costs O(n log² n) rotations.
+// Time Complexity: O(n log n), O(n) for already sorted input
+// Space Complexity: O(n) scratch
+func MergeSortInPlace(arr []int) {
+	StableSortFunc(arr, func(a, b int) bool { return a < b })
+}
+
 // BubbleSort sorts array using bubble sort (for educational purposes)
 // Time Complexity: O(n²)
 // Space Complexity: O(1)
@@ -194,6 +261,111 @@ func heapify(arr []int, n, i int) {
 	}
 }
 
+// countingSortMaxRange caps the counts slice; wider value ranges fall back to RadixSort
+const countingSortMaxRange = 1 << 20
+
+// CountingSort sorts array in-place by counting occurrences of each value (stable)
+// Only practical when the value range k = max-min+1 is small; ranges wider than
+// countingSortMaxRange are handed to RadixSort, which is also stable
+// Time Complexity: O(n + k)
+// Space Complexity: O(n + k)
+func CountingSort(arr []int) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	lo, hi := arr[0], arr[0]
+	for _, v := range arr {
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+
+	// hi-lo can overflow int (MaxInt - MinInt); as uint64 the difference is exact
+	if uint64(hi)-uint64(lo) >= countingSortMaxRange {
+		RadixSort(arr)
+		return
+	}
+
+	// Offsetting by lo lets negative values index the counts slice
+	counts := make([]int, hi-lo+1)
+	for _, v := range arr {
+		counts[v-lo]++
+	}
+
+	// Prefix sums turn counts into the end position of each value's run
+	for i := 1; i < len(counts); i++ {
+		counts[i] += counts[i-1]
+	}
+
+	// Walk backwards so equal values keep their original relative order
+	output := make([]int, len(arr))
+	for i := len(arr) - 1; i >= 0; i-- {
+		counts[arr[i]-lo]--
+		output[counts[arr[i]-lo]] = arr[i]
+	}
+	copy(arr, output)
+}
+
+// radixSignBit flips negative numbers below positive ones when compared as unsigned
+const radixSignBit = uint64(1) << 63
+
+// RadixSort sorts array in-place with LSD radix sort, one byte (base 256) per pass (stable)
+// Time Complexity: O(n * 8) for 64-bit ints, i.e. O(n)
+// Space Complexity: O(n)
+func RadixSort(arr []int) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	// Two's complement puts negatives above positives as unsigned; flipping the sign
+	// bit restores numeric order, so every pass can treat keys as plain bytes
+	keys := make([]uint64, len(arr))
+	for i, v := range arr {
+		keys[i] = uint64(v) ^ radixSignBit
+	}
+
+	buf := make([]uint64, len(arr))
+	for shift := 0; shift < 64; shift += 8 {
+		if radixPass(keys, buf, shift) {
+			keys, buf = buf, keys
+		}
+	}
+
+	for i, k := range keys {
+		arr[i] = int(k ^ radixSignBit)
+	}
+}
+
+// radixPass stably distributes src into dst by the byte at shift
+// Returns false without writing dst if every key shares that byte
+func radixPass(src, dst []uint64, shift int) bool {
+	var counts [256]int
+	for _, k := range src {
+		counts[(k>>shift)&0xFF]++
+	}
+
+	// Nothing to reorder (common for the high bytes of small numbers)
+	for _, c := range counts {
+		if c == len(src) {
+			return false
+		}
+	}
+
+	// Exclusive prefix sums: start position of each bucket
+	pos := 0
+	for b, c := range counts {
+		counts[b] = pos
+		pos += c
+	}
+
+	for _, k := range src {
+		b := (k >> shift) & 0xFF
+		dst[counts[b]] = k
+		counts[b]++
+	}
+	return true
+}
+
 // IsSorted checks if array is sorted
 // Time Complexity: O(n)
 // Space Complexity: O(1)
@@ -219,7 +391,7 @@ func quickSelect(arr []int, low, high, k int) int {
 		return arr[low]
 	}
 
-	pivotIndex := partition(arr, low, high)
+	pivotIndex := partition(arr, low, high, cmp.Compare[int])
 
 	if k == pivotIndex {
 		return arr[k]
@@ -229,3 +401,17 @@ func quickSelect(arr []int, low, high, k int) int {
 		return quickSelect(arr, pivotIndex+1, high, k)
 	}
 }
+
+// WiggleSort rearranges arr in place so that arr[0] <= arr[1] >= arr[2] <= arr[3] ...
+// A single pass is enough: at each odd index the pair must be rising, at each even index
+// falling, and swapping an out-of-order pair never breaks the previous pair's relation.
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func WiggleSort(arr []int) {
+	for i := 1; i < len(arr); i++ {
+		oddIndex := i%2 == 1
+		if (oddIndex && arr[i] < arr[i-1]) || (!oddIndex && arr[i] > arr[i-1]) {
+			arr[i], arr[i-1] = arr[i-1], arr[i]
+		}
+	}
+}
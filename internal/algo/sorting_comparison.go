@@ -0,0 +1,117 @@
+package algo
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Why interviewers ask this:
+// "Which sort would you use?" has no answer without data. Big-O hides constants and
+// ignores input shape: insertion sort beats everything on tiny or nearly sorted input,
+// and a naive QuickSort collapses to O(n²) on already sorted data. Measuring the sorts
+// side by side turns the complexity table into something you can reason about.
+
+// Common pitfalls:
+// - Timing a sort on a slice a previous run already sorted
+// - Only testing random input (misses QuickSort's sorted/reverse worst case)
+// - Comparing in-place and out-of-place sorts without accounting for the copy
+// - Treating one wall-clock sample as a benchmark; use testing.B for real numbers
+
+// Key takeaway:
+// Give every algorithm the same fresh copy of the same input, and vary both size and
+// distribution (random, sorted, reverse, few unique). The shape of the input matters as
+// much as n. This helper is for exploration; go test -bench is the tool for real numbers.
+
+// BenchmarkableSort is a named sorting algorithm that sorts arr in place
+type BenchmarkableSort interface {
+	Name() string
+	Sort(arr []int)
+}
+
+// namedSort adapts a sorting function to BenchmarkableSort
+type namedSort struct {
+	name string
+	sort func(arr []int)
+}
+
+func (s namedSort) Name() string   { return s.name }
+func (s namedSort) Sort(arr []int) { s.sort(arr) }
+
+// SortingAlgorithms returns the package's general-purpose sorts
+func SortingAlgorithms() []BenchmarkableSort {
+	return []BenchmarkableSort{
+		namedSort{"QuickSort", QuickSort},
+		namedSort{"QuickSort3Way", QuickSort3Way},
+		namedSort{"MergeSort", func(arr []int) {
+			// MergeSort returns a new slice; copy it back to sort in place
+			copy(arr, MergeSort(arr))
+		}},
+		namedSort{"HeapSort", HeapSort},
+		namedSort{"InsertionSort", InsertionSort},
+		namedSort{"SelectionSort", SelectionSort},
+		namedSort{"BubbleSort", BubbleSort},
+	}
+}
+
+// SortDistributions lists the input shapes SortingComparison measures
+var SortDistributions = []string{"random", "sorted", "reverse", "few-unique"}
+
+// GenerateSortInput builds an input of size n with the given distribution
+// Unknown distributions fall back to random
+func GenerateSortInput(distribution string, n int, rng *rand.Rand) []int {
+	arr := make([]int, n)
+
+	switch distribution {
+	case "sorted":
+		for i := range arr {
+			arr[i] = i
+		}
+	case "reverse":
+		for i := range arr {
+			arr[i] = n - i
+		}
+	case "few-unique":
+		for i := range arr {
+			arr[i] = rng.Intn(4)
+		}
+	default:
+		for i := range arr {
+			arr[i] = rng.Intn(n + 1)
+		}
+	}
+
+	return arr
+}
+
+// SortingComparison times every algorithm on every size and distribution
+// Results are keyed by "Algorithm/distribution", then by input size. Each algorithm
+// sorts its own copy of the same input, generated from a fixed seed for repeatability.
+// Time Complexity: O(a * d * s * T(n)) for a algorithms, d distributions, s sizes
+// Space Complexity: O(n) for the input copies
+func SortingComparison(sizes []int) map[string]map[int]time.Duration {
+	results := make(map[string]map[int]time.Duration)
+	rng := rand.New(rand.NewSource(1))
+	algorithms := SortingAlgorithms()
+
+	for _, distribution := range SortDistributions {
+		for _, n := range sizes {
+			input := GenerateSortInput(distribution, n, rng)
+
+			for _, algorithm := range algorithms {
+				arr := append([]int(nil), input...)
+
+				start := time.Now()
+				algorithm.Sort(arr)
+				elapsed := time.Since(start)
+
+				key := algorithm.Name() + "/" + distribution
+				if results[key] == nil {
+					results[key] = make(map[int]time.Duration)
+				}
+				results[key][n] = elapsed
+			}
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,71 @@
+package algo
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSortingComparison_CoversEveryCombination(t *testing.T) {
+	sizes := []int{0, 10, 200}
+	results := SortingComparison(sizes)
+
+	expectedKeys := len(SortingAlgorithms()) * len(SortDistributions)
+	if len(results) != expectedKeys {
+		t.Errorf("expected %d algorithm/distribution keys, got %d", expectedKeys, len(results))
+	}
+
+	for _, algorithm := range SortingAlgorithms() {
+		for _, distribution := range SortDistributions {
+			key := algorithm.Name() + "/" + distribution
+			for _, n := range sizes {
+				if _, ok := results[key][n]; !ok {
+					t.Errorf("missing timing for %s at size %d", key, n)
+				}
+			}
+		}
+	}
+}
+
+func TestSortingAlgorithms_AgreeOnEveryDistribution(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+
+	for _, distribution := range SortDistributions {
+		for _, n := range []int{0, 1, 2, 17, 300} {
+			input := GenerateSortInput(distribution, n, rng)
+
+			expected := append([]int(nil), input...)
+			sort.Ints(expected)
+
+			for _, algorithm := range SortingAlgorithms() {
+				arr := append([]int(nil), input...)
+				algorithm.Sort(arr)
+
+				if !reflect.DeepEqual(arr, expected) {
+					t.Errorf("%s on %s input of size %d: expected %v, got %v",
+						algorithm.Name(), distribution, n, expected, arr)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkSortingAlgorithms(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, distribution := range SortDistributions {
+		input := GenerateSortInput(distribution, 1000, rng)
+
+		for _, algorithm := range SortingAlgorithms() {
+			b.Run(fmt.Sprintf("%s/%s", algorithm.Name(), distribution), func(b *testing.B) {
+				arr := make([]int, len(input))
+				for i := 0; i < b.N; i++ {
+					copy(arr, input)
+					algorithm.Sort(arr)
+				}
+			})
+		}
+	}
+}
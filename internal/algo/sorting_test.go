@@ -1,7 +1,10 @@
 package algo
 
 import (
+	"cmp"
+	"math/rand"
 	"reflect"
+	"sort"
 	"testing"
 )
 
@@ -152,6 +155,7 @@ func TestSorting_Duplicates(t *testing.T) {
 		fn   func([]int)
 	}{
 		{"QuickSort", QuickSort},
+		{"QuickSort3Way", QuickSort3Way},
 		{"BubbleSort", BubbleSort},
 		{"InsertionSort", InsertionSort},
 		{"SelectionSort", SelectionSort},
@@ -191,3 +195,309 @@ func TestMergeSort_Stability(t *testing.T) {
 		t.Errorf("MergeSort failed: %v", sorted)
 	}
 }
+
+func isWiggle(arr []int) bool {
+	for i := 1; i < len(arr); i++ {
+		if i%2 == 1 && arr[i] < arr[i-1] {
+			return false
+		}
+		if i%2 == 0 && arr[i] > arr[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func sameElements(a, b []int) bool {
+	counts := make(map[int]int)
+	for _, v := range a {
+		counts[v]++
+	}
+	for _, v := range b {
+		counts[v]--
+	}
+	for _, c := range counts {
+		if c != 0 {
+			return false
+		}
+	}
+	return len(a) == len(b)
+}
+
+func TestWiggleSort(t *testing.T) {
+	tests := [][]int{
+		{3, 5, 2, 1, 6, 4},
+		{1, 2, 3, 4, 5, 6}, // Sorted
+		{6, 5, 4, 3, 2, 1}, // Reverse-sorted
+		{2, 2, 2, 2},       // All equal
+		{1},
+		{},
+	}
+
+	for _, input := range tests {
+		arr := make([]int, len(input))
+		copy(arr, input)
+
+		WiggleSort(arr)
+
+		if !isWiggle(arr) {
+			t.Errorf("WiggleSort(%v): result %v is not a wiggle", input, arr)
+		}
+		if !sameElements(arr, input) {
+			t.Errorf("WiggleSort(%v): result %v is not a permutation", input, arr)
+		}
+	}
+}
+
+func TestWiggleSort_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for trial := 0; trial < 100; trial++ {
+		input := make([]int, rng.Intn(20))
+		for i := range input {
+			input[i] = rng.Intn(10) // Small range forces duplicates
+		}
+
+		arr := make([]int, len(input))
+		copy(arr, input)
+		WiggleSort(arr)
+
+		if !isWiggle(arr) || !sameElements(arr, input) {
+			t.Fatalf("WiggleSort(%v) produced %v", input, arr)
+		}
+	}
+}
+
+func TestNonComparisonSorts(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	large := make([]int, 2000)
+	for i := range large {
+		large[i] = rng.Intn(2001) - 1000 // Mixed signs, many duplicates
+	}
+
+	tests := [][]int{
+		{},
+		{42},
+		{64, 34, 25, 12, 22, 11, 90},
+		{-5, 2, -3, 0, 1, -1},
+		{3, 3, 3, 3},
+		large,
+	}
+
+	sorts := []struct {
+		name string
+		fn   func([]int)
+	}{
+		{"CountingSort", CountingSort},
+		{"RadixSort", RadixSort},
+	}
+
+	for _, s := range sorts {
+		for _, input := range tests {
+			arr := make([]int, len(input))
+			copy(arr, input)
+			expected := make([]int, len(input))
+			copy(expected, input)
+			sort.Ints(expected)
+
+			s.fn(arr)
+
+			if !reflect.DeepEqual(arr, expected) {
+				t.Errorf("%s(%v): expected %v, got %v", s.name, input, expected, arr)
+			}
+		}
+	}
+}
+
+func TestRadixSort_ExtremeValues(t *testing.T) {
+	maxInt := int(^uint(0) >> 1)
+	minInt := -maxInt - 1
+	arr := []int{0, maxInt, -1, minInt, 1, minInt + 1, maxInt - 1}
+
+	RadixSort(arr)
+
+	expected := []int{minInt, minInt + 1, -1, 0, 1, maxInt - 1, maxInt}
+	if !reflect.DeepEqual(arr, expected) {
+		t.Errorf("expected %v, got %v", expected, arr)
+	}
+}
+
+func TestCountingSort_ExtremeAndWideRanges(t *testing.T) {
+	maxInt := int(^uint(0) >> 1)
+	minInt := -maxInt - 1
+
+	tests := [][]int{
+		{maxInt, minInt},
+		{0, maxInt, -1, minInt, 1},
+		{maxInt, maxInt - 1, maxInt - 2}, // Narrow range near the top
+		{minInt + 2, minInt, minInt + 1}, // Narrow range near the bottom
+		{5, -3, 1 << 40, 7, -(1 << 40)},  // Wide range: RadixSort fallback
+	}
+
+	for _, input := range tests {
+		arr := make([]int, len(input))
+		copy(arr, input)
+		expected := make([]int, len(input))
+		copy(expected, input)
+		sort.Ints(expected)
+
+		CountingSort(arr)
+
+		if !reflect.DeepEqual(arr, expected) {
+			t.Errorf("CountingSort(%v): expected %v, got %v", input, expected, arr)
+		}
+	}
+}
+
+func TestNonComparisonSorts_Stability(t *testing.T) {
+	// Encode (key, origIndex) as key<<8 | origIndex. The final order is only correct if
+	// the pass that sorts by key keeps equal keys in the order of the earlier passes.
+	keys := []int{2, 0, 1, 2, 0, 1, 2, 0}
+	arr := make([]int, len(keys))
+	for i, k := range keys {
+		arr[i] = k<<8 | i
+	}
+
+	expected := []int{0<<8 | 1, 0<<8 | 4, 0<<8 | 7, 1<<8 | 2, 1<<8 | 5, 2<<8 | 0, 2<<8 | 3, 2<<8 | 6}
+	for _, fn := range []func([]int){CountingSort, RadixSort} {
+		encoded := make([]int, len(arr))
+		copy(encoded, arr)
+		fn(encoded)
+		if !reflect.DeepEqual(encoded, expected) {
+			t.Errorf("expected %v, got %v", expected, encoded)
+		}
+	}
+
+	// A single radix pass on the key byte alone: equal keys must keep input order
+	src := []uint64{0x0201, 0x0002, 0x0103, 0x0004, 0x0205, 0x0106} // Key in byte 1, index in byte 0
+	dst := make([]uint64, len(src))
+	radixPass(src, dst, 8)
+
+	expectedPass := []uint64{0x0002, 0x0004, 0x0103, 0x0106, 0x0201, 0x0205}
+	if !reflect.DeepEqual(dst, expectedPass) {
+		t.Errorf("radixPass: expected %x, got %x", expectedPass, dst)
+	}
+}
+
+func TestMergeSortInPlace(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	random := make([]int, 1000)
+	for i := range random {
+		random[i] = rng.Intn(100) - 50
+	}
+
+	tests := [][]int{
+		{},
+		{42},
+		{64, 34, 25, 12, 22, 11, 90},
+		{1, 2, 3, 4, 5},
+		{5, 4, 3, 2, 1},
+		random,
+	}
+
+	for _, input := range tests {
+		arr := make([]int, len(input))
+		copy(arr, input)
+		expected := make([]int, len(input))
+		copy(expected, input)
+		sort.SliceStable(expected, func(i, j int) bool { return expected[i] < expected[j] })
+
+		MergeSortInPlace(arr) // Sorts arr itself; no return value to forget
+
+		if !reflect.DeepEqual(arr, expected) {
+			t.Errorf("MergeSortInPlace(%v): expected %v, got %v", input, expected, arr)
+		}
+	}
+}
+
+func TestQuickSort3Way(t *testing.T) {
+	rng := rand.New(rand.NewSource(9))
+	random := make([]int, 1000)
+	for i := range random {
+		random[i] = rng.Intn(1000) - 500
+	}
+
+	tests := [][]int{
+		{},
+		{42},
+		{64, 34, 25, 12, 22, 11, 90},
+		{1, 2, 3, 4, 5},
+		{5, 4, 3, 2, 1},
+		{7, 7, 7, 7, 7},
+		random,
+	}
+
+	for _, input := range tests {
+		arr := make([]int, len(input))
+		copy(arr, input)
+		expected := make([]int, len(input))
+		copy(expected, input)
+		sort.Ints(expected)
+
+		QuickSort3Way(arr)
+
+		if !reflect.DeepEqual(arr, expected) {
+			t.Errorf("QuickSort3Way(%v): expected %v, got %v", input, expected, arr)
+		}
+	}
+}
+
+// quickSortComparisons runs QuickSort's recursion with a counting comparator
+func quickSortComparisons(arr []int) int {
+	count := 0
+	quickSortHelper(arr, 0, len(arr)-1, func(a, b int) int {
+		count++
+		return cmp.Compare(a, b)
+	})
+	return count
+}
+
+// quickSort3WayComparisons runs QuickSort3Way's recursion with a counting comparator
+func quickSort3WayComparisons(arr []int) int {
+	count := 0
+	quickSort3WayHelper(arr, 0, len(arr)-1, func(a, b int) int {
+		count++
+		return cmp.Compare(a, b)
+	})
+	return count
+}
+
+func TestQuickSort3Way_DuplicateHeavyComparisons(t *testing.T) {
+	n := 3000
+	allSame := make([]int, n)
+	threeValues := make([]int, n)
+	rng := rand.New(rand.NewSource(2))
+	for i := range threeValues {
+		allSame[i] = 4
+		threeValues[i] = rng.Intn(3)
+	}
+
+	tests := []struct {
+		name  string
+		input []int
+	}{
+		{"all identical", allSame},
+		{"three distinct values", threeValues},
+	}
+
+	for _, tt := range tests {
+		twoWay := make([]int, n)
+		copy(twoWay, tt.input)
+		threeWay := make([]int, n)
+		copy(threeWay, tt.input)
+
+		twoWayCount := quickSortComparisons(twoWay)
+		threeWayCount := quickSort3WayComparisons(threeWay)
+
+		if !IsSorted(twoWay) || !IsSorted(threeWay) {
+			t.Fatalf("%s: result not sorted", tt.name)
+		}
+		// One pass per distinct value, so the 3-way sort is linear here
+		if threeWayCount > 3*n {
+			t.Errorf("%s: expected at most %d 3-way comparisons, got %d", tt.name, 3*n, threeWayCount)
+		}
+		if threeWayCount*100 > twoWayCount {
+			t.Errorf("%s: expected far fewer comparisons than 2-way (%d), got %d", tt.name, twoWayCount, threeWayCount)
+		}
+	}
+}
@@ -37,6 +37,24 @@ func TwoSum(arr []int, target int) []int {
 	return []int{-1, -1} // Not found
 }
 
+// TwoSumUnsorted finds indices i < j with nums[i] + nums[j] == target in an unsorted array
+// Each element is used at most once; checking before storing keeps an element from pairing with itself
+// Same hash-map pass as leetcode.TwoSum (internal/leetcode/two_sum.go); this version reports a miss with ok instead of an error
+// Time Complexity: O(n)
+// Space Complexity: O(n) for the value-to-index map
+func TwoSumUnsorted(nums []int, target int) (int, int, bool) {
+	seen := make(map[int]int, len(nums))
+
+	for j, num := range nums {
+		if i, ok := seen[target-num]; ok {
+			return i, j, true
+		}
+		seen[num] = j
+	}
+
+	return -1, -1, false
+}
+
 // ThreeSum finds all unique triplets that sum to zero
 // Time Complexity: O(n²)
 // Space Complexity: O(1) excluding output
@@ -198,6 +216,49 @@ func PartitionArray(arr []int, pivot int) int {
 	return left
 }
 
+// BackspaceCompare reports whether s and t are equal once '#' is applied as a backspace
+// Walks both strings from the end, skipping characters erased by later backspaces
+// Time Complexity: O(n + m)
+// Space Complexity: O(1)
+func BackspaceCompare(s, t string) bool {
+	i, j := len(s)-1, len(t)-1
+
+	for i >= 0 || j >= 0 {
+		i = nextTypedIndex(s, i)
+		j = nextTypedIndex(t, j)
+
+		if i < 0 || j < 0 {
+			// Equal only if both strings are exhausted together
+			return i < 0 && j < 0
+		}
+		if s[i] != t[j] {
+			return false
+		}
+
+		i--
+		j--
+	}
+
+	return true
+}
+
+// nextTypedIndex returns the index of the last surviving character at or before i, or -1
+func nextTypedIndex(s string, i int) int {
+	skip := 0
+	for i >= 0 {
+		switch {
+		case s[i] == '#':
+			skip++
+		case skip > 0:
+			skip--
+		default:
+			return i
+		}
+		i--
+	}
+	return i
+}
+
 // Helper functions
 func min(a, b int) int {
 	if a < b {
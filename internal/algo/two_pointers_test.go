@@ -165,3 +165,53 @@ func TestTwoSum_MultipleValidPairs(t *testing.T) {
 		t.Errorf("expected sum %d, got %d", target, sum)
 	}
 }
+
+func TestBackspaceCompare(t *testing.T) {
+	tests := []struct {
+		s, t     string
+		expected bool
+	}{
+		{"ab#c", "ad#c", true},
+		{"a##c", "#a#c", true},
+		{"ab##", "c#d#", true},
+		{"a#c", "b", false},
+		{"abc#", "abd#", true},
+		{"xy#z", "xzz#", true},
+		{"bxj##tw", "bxo#j##tw", true},
+		{"bbbextm", "bbb#extm", false},
+		{"", "###", true},
+		{"a", "", false},
+	}
+
+	for _, tt := range tests {
+		result := BackspaceCompare(tt.s, tt.t)
+		if result != tt.expected {
+			t.Errorf("BackspaceCompare(%q, %q): expected %v, got %v", tt.s, tt.t, tt.expected, result)
+		}
+	}
+}
+
+func TestTwoSumUnsorted(t *testing.T) {
+	tests := []struct {
+		nums   []int
+		target int
+		i, j   int
+		ok     bool
+	}{
+		{[]int{2, 7, 11, 15}, 9, 0, 1, true},
+		{[]int{3, 2, 4}, 6, 1, 2, true}, // 3 must not pair with itself
+		{[]int{3, 3}, 6, 0, 1, true},    // Equal values at different indices
+		{[]int{-1, 8, -4, 5}, 1, 2, 3, true},
+		{[]int{1, 2, 3}, 7, -1, -1, false},
+		{[]int{5}, 10, -1, -1, false}, // Single element can't be used twice
+		{[]int{}, 0, -1, -1, false},
+	}
+
+	for _, tt := range tests {
+		i, j, ok := TwoSumUnsorted(tt.nums, tt.target)
+		if i != tt.i || j != tt.j || ok != tt.ok {
+			t.Errorf("TwoSumUnsorted(%v, %d): expected (%d, %d, %v), got (%d, %d, %v)",
+				tt.nums, tt.target, tt.i, tt.j, tt.ok, i, j, ok)
+		}
+	}
+}
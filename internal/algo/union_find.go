@@ -0,0 +1,81 @@
+package algo
+
+// Why interviewers ask this:
+// Union-Find (Disjoint Set Union) answers "are these two things connected?" in nearly
+// constant time. It powers Kruskal's MST, cycle detection, connected components, and
+// dynamic connectivity questions, and interviewers expect both optimizations.
+
+// Common pitfalls:
+// - Forgetting path compression, leaving Find at O(n) on degenerate chains
+// - Union without rank/size, which builds tall trees
+// - Unioning the elements instead of their roots
+// - Not tracking the number of components when the problem asks for it
+
+// Key takeaway:
+// Each set is a tree identified by its root. Find follows parents to the root and
+// compresses the path; Union attaches the shorter tree under the taller one. With both
+// optimizations operations run in amortized O(α(n)), effectively constant.
+
+// UnionFind is a disjoint-set forest over elements 0..n-1
+// Time Complexity: Find/Union amortized O(α(n))
+// Space Complexity: O(n)
+type UnionFind struct {
+	parent []int
+	rank   []int
+	count  int
+}
+
+// NewUnionFind creates n singleton sets
+func NewUnionFind(n int) *UnionFind {
+	parent := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+	}
+
+	return &UnionFind{
+		parent: parent,
+		rank:   make([]int, n),
+		count:  n,
+	}
+}
+
+// Find returns the root of x's set, compressing the path along the way
+func (uf *UnionFind) Find(x int) int {
+	if uf.parent[x] != x {
+		uf.parent[x] = uf.Find(uf.parent[x])
+	}
+	return uf.parent[x]
+}
+
+// Union merges the sets containing x and y
+// Returns false if they were already in the same set
+func (uf *UnionFind) Union(x, y int) bool {
+	rootX, rootY := uf.Find(x), uf.Find(y)
+	if rootX == rootY {
+		return false
+	}
+
+	// Union by rank: attach the shorter tree under the taller one
+	switch {
+	case uf.rank[rootX] < uf.rank[rootY]:
+		uf.parent[rootX] = rootY
+	case uf.rank[rootX] > uf.rank[rootY]:
+		uf.parent[rootY] = rootX
+	default:
+		uf.parent[rootY] = rootX
+		uf.rank[rootX]++
+	}
+
+	uf.count--
+	return true
+}
+
+// Connected reports whether x and y are in the same set
+func (uf *UnionFind) Connected(x, y int) bool {
+	return uf.Find(x) == uf.Find(y)
+}
+
+// Count returns the number of disjoint sets
+func (uf *UnionFind) Count() int {
+	return uf.count
+}
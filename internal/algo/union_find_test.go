@@ -0,0 +1,52 @@
+package algo
+
+import "testing"
+
+func TestUnionFind_Basic(t *testing.T) {
+	uf := NewUnionFind(5)
+
+	if uf.Count() != 5 {
+		t.Errorf("expected 5 sets, got %d", uf.Count())
+	}
+
+	uf.Union(0, 1)
+	uf.Union(3, 4)
+
+	if !uf.Connected(0, 1) || !uf.Connected(3, 4) {
+		t.Error("expected unioned elements to be connected")
+	}
+	if uf.Connected(1, 3) {
+		t.Error("0-1 and 3-4 should not be connected")
+	}
+	if uf.Count() != 3 {
+		t.Errorf("expected 3 sets, got %d", uf.Count())
+	}
+}
+
+func TestUnionFind_UnionSameSet(t *testing.T) {
+	uf := NewUnionFind(3)
+
+	if !uf.Union(0, 1) {
+		t.Error("first union should succeed")
+	}
+	if uf.Union(1, 0) {
+		t.Error("union of already connected elements should return false")
+	}
+	if uf.Count() != 2 {
+		t.Errorf("expected 2 sets, got %d", uf.Count())
+	}
+}
+
+func TestUnionFind_Transitive(t *testing.T) {
+	uf := NewUnionFind(4)
+	uf.Union(0, 1)
+	uf.Union(1, 2)
+	uf.Union(2, 3)
+
+	if !uf.Connected(0, 3) {
+		t.Error("connectivity should be transitive")
+	}
+	if uf.Count() != 1 {
+		t.Errorf("expected 1 set, got %d", uf.Count())
+	}
+}
@@ -0,0 +1,268 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Why interviewers ask this:
+// Rate limiters, TTL caches, retries with backoff, schedulers - all depend on time, and
+// tests that call time.Sleep are slow and flaky. "How would you test this?" usually leads
+// to injecting a clock. Knowing how to build a fake one shows you can design for testability.
+
+// Common pitfalls:
+// - Calling time.Now() and time.After() directly deep inside business logic
+// - A fake clock that jumps straight to the target time, firing timers out of order
+// - Fake timer channels that block Advance when nobody is receiving
+// - Tests that Advance before the goroutine under test has registered its timer
+// - Running AfterFunc callbacks with the clock's lock held (deadlocks if they use the clock)
+
+// Key takeaway:
+// Depend on a small Clock interface and pass RealClock in production, MockClock in tests.
+// MockClock.Advance walks through pending timers in deadline order, setting Now to each
+// deadline before firing it, so code observes the same sequence it would in real time.
+// Use BlockUntil to wait for the code under test to start waiting before advancing.
+
+// Clock is the subset of the time package that time-dependent code needs
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+	Sleep(d time.Duration)
+	NewTicker(d time.Duration) Ticker
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a pending AfterFunc call that can be cancelled, like *time.Timer
+type Timer interface {
+	// Stop prevents the call from running; it returns false if it already ran or was stopped
+	Stop() bool
+}
+
+// Ticker delivers ticks at regular intervals, like *time.Ticker
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// RealClock is a Clock backed by the time package
+type RealClock struct{}
+
+// Now returns the current local time
+func (RealClock) Now() time.Time { return time.Now() }
+
+// After waits for d to elapse and then sends the current time on the returned channel
+func (RealClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// Sleep pauses the current goroutine for at least d
+func (RealClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+// NewTicker returns a ticker that ticks every d
+func (RealClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+// AfterFunc calls f in its own goroutine once d has elapsed
+func (RealClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+// realTicker adapts *time.Ticker, whose channel is a field, to the Ticker interface
+type realTicker struct {
+	ticker *time.Ticker
+}
+
+func (t realTicker) C() <-chan time.Time { return t.ticker.C }
+func (t realTicker) Stop()               { t.ticker.Stop() }
+
+// mockTimer is a pending After or Ticker registration on a MockClock
+type mockTimer struct {
+	deadline time.Time
+	period   time.Duration // Zero for one-shot timers
+	ch       chan time.Time
+	fn       func() // Set for AfterFunc timers, which call fn instead of sending on ch
+	seq      int    // Creation order, breaks ties between equal deadlines
+}
+
+// MockClock is a Clock whose time only moves when Advance is called
+type MockClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	timers  []*mockTimer
+	nextSeq int
+	changed *sync.Cond // Broadcast whenever timers are added or removed
+}
+
+// NewMockClock creates a MockClock starting at start
+func NewMockClock(start time.Time) *MockClock {
+	c := &MockClock{now: start}
+	c.changed = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now returns the mock's current time
+func (c *MockClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After returns a channel that receives the mock time once Advance moves past d from now
+// A non-positive d fires immediately
+func (c *MockClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+
+	c.addTimerLocked(&mockTimer{deadline: c.now.Add(d), ch: ch})
+	return ch
+}
+
+// Sleep blocks until another goroutine advances the clock by at least d
+func (c *MockClock) Sleep(d time.Duration) {
+	<-c.After(d)
+}
+
+// NewTicker returns a ticker that ticks every d of mock time
+// Like time.Ticker, ticks are dropped if the receiver falls behind; d must be positive
+func (c *MockClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("clock: non-positive interval for NewTicker")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	timer := &mockTimer{deadline: c.now.Add(d), period: d, ch: make(chan time.Time, 1)}
+	c.addTimerLocked(timer)
+	return &mockTicker{clock: c, timer: timer}
+}
+
+// AfterFunc calls f once Advance moves past d from now
+// Unlike time.AfterFunc, f runs synchronously in the goroutine calling Advance, so it has
+// finished by the time Advance returns. A non-positive d fires on the next Advance.
+func (c *MockClock) AfterFunc(d time.Duration, f func()) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	timer := &mockTimer{deadline: c.now.Add(max(d, 0)), fn: f}
+	c.addTimerLocked(timer)
+	return &mockAfterFunc{clock: c, timer: timer}
+}
+
+// Advance moves the clock forward by d, firing every timer whose deadline is reached in
+// deadline order. Now reports each timer's deadline at the moment it fires.
+func (c *MockClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	target := c.now.Add(d)
+
+	for len(c.timers) > 0 && !c.timers[0].deadline.After(target) {
+		timer := c.timers[0]
+		c.timers = c.timers[1:]
+		c.now = timer.deadline
+
+		if timer.fn != nil {
+			// Release the lock so the callback can call Now, AfterFunc or Stop
+			c.changed.Broadcast()
+			c.mu.Unlock()
+			timer.fn()
+			c.mu.Lock()
+			continue
+		}
+
+		// Non-blocking send: a full channel means the receiver hasn't caught up
+		select {
+		case timer.ch <- c.now:
+		default:
+		}
+
+		if timer.period > 0 {
+			timer.deadline = timer.deadline.Add(timer.period)
+			c.addTimerLocked(timer)
+		}
+	}
+
+	if c.now.Before(target) { // A concurrent Advance during a callback may have gone further
+		c.now = target
+	}
+	c.changed.Broadcast()
+}
+
+// BlockUntil waits until at least n timers (After, Sleep, AfterFunc or Ticker) are pending
+// Tests call it to make sure the code under test is waiting before they Advance
+func (c *MockClock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for len(c.timers) < n {
+		c.changed.Wait()
+	}
+}
+
+// addTimerLocked inserts timer keeping c.timers sorted by deadline, then creation order
+// Caller must hold c.mu
+func (c *MockClock) addTimerLocked(timer *mockTimer) {
+	if timer.seq == 0 {
+		c.nextSeq++
+		timer.seq = c.nextSeq
+	}
+
+	i := sort.Search(len(c.timers), func(i int) bool {
+		other := c.timers[i]
+		if !other.deadline.Equal(timer.deadline) {
+			return other.deadline.After(timer.deadline)
+		}
+		return other.seq > timer.seq
+	})
+
+	c.timers = append(c.timers, nil)
+	copy(c.timers[i+1:], c.timers[i:])
+	c.timers[i] = timer
+	c.changed.Broadcast()
+}
+
+// removeTimer unregisters timer, reporting whether it was still pending
+func (c *MockClock) removeTimer(timer *mockTimer) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, pending := range c.timers {
+		if pending == timer {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			c.changed.Broadcast()
+			return true
+		}
+	}
+	return false
+}
+
+// mockAfterFunc is a one-shot callback registered on a MockClock
+type mockAfterFunc struct {
+	clock *MockClock
+	timer *mockTimer
+}
+
+// Stop cancels the callback if it hasn't run yet
+func (t *mockAfterFunc) Stop() bool {
+	return t.clock.removeTimer(t.timer)
+}
+
+// mockTicker is a periodic timer registered on a MockClock
+type mockTicker struct {
+	clock *MockClock
+	timer *mockTimer
+}
+
+func (t *mockTicker) C() <-chan time.Time { return t.timer.ch }
+
+// Stop removes the ticker from the clock; no more ticks are sent
+func (t *mockTicker) Stop() {
+	t.clock.removeTimer(t.timer)
+}
@@ -0,0 +1,198 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// fired reports whether ch has a value ready, and returns it
+func fired(ch <-chan time.Time) (time.Time, bool) {
+	select {
+	case v := <-ch:
+		return v, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func TestMockClock_NowOnlyMovesOnAdvance(t *testing.T) {
+	c := NewMockClock(epoch)
+
+	if !c.Now().Equal(epoch) {
+		t.Fatalf("expected %v, got %v", epoch, c.Now())
+	}
+
+	c.Advance(90 * time.Second)
+	if expected := epoch.Add(90 * time.Second); !c.Now().Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, c.Now())
+	}
+}
+
+func TestMockClock_AdvanceTriggersAfter(t *testing.T) {
+	c := NewMockClock(epoch)
+	ch := c.After(time.Minute)
+
+	c.Advance(59 * time.Second)
+	if _, ok := fired(ch); ok {
+		t.Fatal("After fired before its deadline")
+	}
+
+	c.Advance(time.Second)
+	v, ok := fired(ch)
+	if !ok {
+		t.Fatal("After did not fire at its deadline")
+	}
+	if expected := epoch.Add(time.Minute); !v.Equal(expected) {
+		t.Errorf("expected fire time %v, got %v", expected, v)
+	}
+}
+
+func TestMockClock_TimersFireInDeadlineOrder(t *testing.T) {
+	c := NewMockClock(epoch)
+
+	// Registered out of order
+	late := c.After(3 * time.Second)
+	early := c.After(1 * time.Second)
+	middle := c.After(2 * time.Second)
+
+	c.Advance(2 * time.Second)
+
+	if v, ok := fired(early); !ok || !v.Equal(epoch.Add(time.Second)) {
+		t.Errorf("expected early timer at +1s, got %v (fired=%v)", v, ok)
+	}
+	if v, ok := fired(middle); !ok || !v.Equal(epoch.Add(2*time.Second)) {
+		t.Errorf("expected middle timer at +2s, got %v (fired=%v)", v, ok)
+	}
+	if _, ok := fired(late); ok {
+		t.Error("late timer fired too early")
+	}
+
+	c.Advance(time.Second)
+	if v, ok := fired(late); !ok || !v.Equal(epoch.Add(3*time.Second)) {
+		t.Errorf("expected late timer at +3s, got %v (fired=%v)", v, ok)
+	}
+}
+
+func TestMockClock_SleepReturnsAfterAdvance(t *testing.T) {
+	c := NewMockClock(epoch)
+	woke := make(chan time.Time)
+
+	go func() {
+		c.Sleep(5 * time.Second)
+		woke <- c.Now()
+	}()
+
+	c.BlockUntil(1) // Sleeper is registered
+	c.Advance(5 * time.Second)
+
+	select {
+	case now := <-woke:
+		if !now.Equal(epoch.Add(5 * time.Second)) {
+			t.Errorf("expected sleeper to wake at +5s, got %v", now)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Sleep did not return after Advance")
+	}
+}
+
+func TestMockClock_TickerTicksAndStops(t *testing.T) {
+	c := NewMockClock(epoch)
+	ticker := c.NewTicker(10 * time.Second)
+
+	for i := 1; i <= 3; i++ {
+		c.Advance(10 * time.Second)
+		v, ok := fired(ticker.C())
+		if !ok || !v.Equal(epoch.Add(time.Duration(i)*10*time.Second)) {
+			t.Errorf("tick %d: expected %v, got %v (fired=%v)", i, epoch.Add(time.Duration(i)*10*time.Second), v, ok)
+		}
+	}
+
+	// Like time.Ticker, ticks are dropped while the receiver is behind
+	c.Advance(30 * time.Second)
+	if v, _ := fired(ticker.C()); !v.Equal(epoch.Add(40 * time.Second)) {
+		t.Errorf("expected only the first missed tick (+40s), got %v", v)
+	}
+
+	ticker.Stop()
+	c.Advance(time.Minute)
+	if _, ok := fired(ticker.C()); ok {
+		t.Error("stopped ticker should not tick")
+	}
+}
+
+func TestMockClock_NonPositiveAfterFiresImmediately(t *testing.T) {
+	c := NewMockClock(epoch)
+
+	if _, ok := fired(c.After(0)); !ok {
+		t.Error("After(0) should fire without advancing")
+	}
+}
+
+func TestMockClock_AfterFuncRunsDuringAdvance(t *testing.T) {
+	c := NewMockClock(epoch)
+
+	var firedAt []time.Time
+	c.AfterFunc(2*time.Second, func() {
+		firedAt = append(firedAt, c.Now()) // Using the clock inside the callback must not deadlock
+	})
+	stopped := c.AfterFunc(time.Second, func() {
+		t.Error("stopped callback should not run")
+	})
+
+	if !stopped.Stop() {
+		t.Error("Stop on a pending callback should return true")
+	}
+	if stopped.Stop() {
+		t.Error("second Stop should return false")
+	}
+
+	c.Advance(time.Second)
+	if len(firedAt) != 0 {
+		t.Fatal("callback ran before its deadline")
+	}
+
+	c.Advance(5 * time.Second)
+	if len(firedAt) != 1 || !firedAt[0].Equal(epoch.Add(2*time.Second)) {
+		t.Errorf("expected one call at %v, got %v", epoch.Add(2*time.Second), firedAt)
+	}
+	if !c.Now().Equal(epoch.Add(6 * time.Second)) {
+		t.Errorf("expected clock at %v, got %v", epoch.Add(6*time.Second), c.Now())
+	}
+}
+
+func TestMockClock_AfterFuncCanReschedule(t *testing.T) {
+	c := NewMockClock(epoch)
+
+	calls := 0
+	var tick func()
+	tick = func() {
+		calls++
+		c.AfterFunc(time.Second, tick) // Registering from a callback fires within the same Advance
+	}
+	c.AfterFunc(time.Second, tick)
+
+	c.Advance(3 * time.Second)
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRealClock_ImplementsClock(t *testing.T) {
+	var c Clock = RealClock{}
+
+	before := time.Now()
+	<-c.After(time.Millisecond)
+	if c.Now().Before(before) {
+		t.Error("real clock went backwards")
+	}
+
+	ticker := c.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+	<-ticker.C()
+
+	done := make(chan struct{})
+	c.AfterFunc(time.Millisecond, func() { close(done) })
+	<-done
+}
@@ -0,0 +1,92 @@
+package concurrency
+
+import (
+	"errors"
+	"sync"
+)
+
+// Why interviewers ask this:
+// "Share memory by communicating" is Go's concurrency motto, and the actor model is its
+// purest form: one goroutine owns the state and everyone else talks to it through a
+// mailbox. Interviewers use it to contrast message passing with mutex-based designs.
+
+// Common pitfalls:
+// - Reading or writing actor state from outside the actor goroutine (defeats the model)
+// - Sending on the mailbox after it was closed (panic)
+// - Stopping by dropping queued messages instead of draining them
+// - Unbounded mailboxes hiding backpressure problems
+
+// Key takeaway:
+// A single goroutine ranges over the mailbox and applies handler(state, msg) -> state,
+// so state is only ever touched sequentially and needs no lock. Stop closes the mailbox;
+// range drains what's left and exits, and Stop returns the final state.
+
+// ErrActorStopped is returned when sending to an actor that has been stopped
+var ErrActorStopped = errors.New("actor is stopped")
+
+// Actor owns a state of type S and processes messages of type M one at a time
+type Actor[S any, M any] struct {
+	mailbox chan M
+	handler func(S, M) S
+	state   S
+	done    chan struct{}
+
+	// mu guards stopped and the mailbox close so Send never races with Stop
+	mu       sync.RWMutex
+	stopped  bool
+	stopOnce sync.Once
+}
+
+// NewActor starts an actor with the given initial state, mailbox capacity and handler
+func NewActor[S any, M any](initial S, mailboxSize int, handler func(S, M) S) *Actor[S, M] {
+	if mailboxSize < 0 {
+		mailboxSize = 0
+	}
+
+	a := &Actor[S, M]{
+		mailbox: make(chan M, mailboxSize),
+		handler: handler,
+		state:   initial,
+		done:    make(chan struct{}),
+	}
+
+	go a.run()
+	return a
+}
+
+// run is the only goroutine that touches a.state until Stop observes done
+func (a *Actor[S, M]) run() {
+	defer close(a.done)
+
+	for msg := range a.mailbox {
+		a.state = a.handler(a.state, msg)
+	}
+}
+
+// Send enqueues msg, blocking if the mailbox is full
+// Returns ErrActorStopped if Stop has been called
+func (a *Actor[S, M]) Send(msg M) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.stopped {
+		return ErrActorStopped
+	}
+
+	a.mailbox <- msg
+	return nil
+}
+
+// Stop stops accepting messages, waits for queued messages to be processed,
+// and returns the final state. Calling Stop more than once is safe.
+func (a *Actor[S, M]) Stop() S {
+	a.stopOnce.Do(func() {
+		a.mu.Lock()
+		a.stopped = true
+		close(a.mailbox)
+		a.mu.Unlock()
+	})
+
+	<-a.done
+	return a.state
+}
@@ -0,0 +1,80 @@
+package concurrency
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestActor_ConcurrentCounterWithoutMutex(t *testing.T) {
+	counter := NewActor(0, 16, func(state int, delta int) int {
+		return state + delta
+	})
+
+	senders := 50
+	perSender := 100
+	var wg sync.WaitGroup
+
+	for i := 0; i < senders; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perSender; j++ {
+				if err := counter.Send(1); err != nil {
+					t.Errorf("unexpected send error: %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	final := counter.Stop()
+	if final != senders*perSender {
+		t.Errorf("expected %d, got %d", senders*perSender, final)
+	}
+}
+
+func TestActor_ProcessesInOrder(t *testing.T) {
+	log := NewActor([]string{}, 0, func(state []string, msg string) []string {
+		return append(state, msg)
+	})
+
+	for _, msg := range []string{"a", "b", "c"} {
+		log.Send(msg)
+	}
+
+	expected := []string{"a", "b", "c"}
+	if result := log.Stop(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestActor_StopDrainsMailbox(t *testing.T) {
+	actor := NewActor(0, 100, func(state int, msg int) int {
+		return state + msg
+	})
+
+	// Queue messages that may not be processed yet when Stop is called
+	for i := 1; i <= 100; i++ {
+		actor.Send(i)
+	}
+
+	if final := actor.Stop(); final != 5050 {
+		t.Errorf("expected 5050 after draining, got %d", final)
+	}
+}
+
+func TestActor_SendAfterStop(t *testing.T) {
+	actor := NewActor(0, 1, func(state int, msg int) int { return state + msg })
+	actor.Stop()
+
+	if err := actor.Send(1); !errors.Is(err, ErrActorStopped) {
+		t.Errorf("expected ErrActorStopped, got %v", err)
+	}
+
+	// Stop is idempotent
+	if final := actor.Stop(); final != 0 {
+		t.Errorf("expected 0, got %d", final)
+	}
+}
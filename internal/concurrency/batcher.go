@@ -0,0 +1,146 @@
+package concurrency
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/farhancdr/backend-interview-handbook/internal/clock"
+)
+
+// Why interviewers ask this:
+// Batching is everywhere in ingestion pipelines: log shippers, metrics exporters, bulk DB
+// inserts. "Flush when the batch is full OR after a max delay" balances throughput against
+// latency, and implementing it correctly requires coordinating a buffer, a timer, and
+// shutdown.
+
+// Common pitfalls:
+// - Only flushing on size, so a trickle of items sits in the buffer forever
+// - A stale timer from a previous batch flushing the next batch early
+// - Losing buffered items on shutdown because Close doesn't flush
+// - Testing delay-based flushing with real sleeps (slow and flaky)
+
+// Key takeaway:
+// Start a timer when the first item enters an empty buffer and flush on whichever comes
+// first: size limit or timer. Tag each batch with a generation number so a late timer for
+// an already-flushed batch is ignored. Inject a clock.Clock to test without sleeping.
+
+// ErrBatcherClosed is returned when adding to a closed Batcher
+var ErrBatcherClosed = errors.New("batcher is closed")
+
+// Batcher groups items and passes them to a handler in batches
+// The handler is called with the batcher's lock held, so batches are delivered one at a
+// time and in order; a slow handler applies backpressure to Add.
+type Batcher[T any] struct {
+	mu         sync.Mutex
+	buffer     []T
+	maxSize    int
+	maxDelay   time.Duration
+	handler    func([]T)
+	clock      clock.Clock
+	timer      clock.Timer
+	generation int
+	closed     bool
+}
+
+// BatcherOption configures a Batcher
+type BatcherOption[T any] func(*Batcher[T])
+
+// WithBatcherClock replaces the real clock, letting tests trigger the delay with Advance
+func WithBatcherClock[T any](c clock.Clock) BatcherOption[T] {
+	return func(b *Batcher[T]) {
+		b.clock = c
+	}
+}
+
+// NewBatcher creates a batcher that flushes at maxSize items or maxDelay after the
+// first item of a batch arrives, whichever happens first
+func NewBatcher[T any](maxSize int, maxDelay time.Duration, handler func([]T), opts ...BatcherOption[T]) *Batcher[T] {
+	if maxSize < 1 {
+		maxSize = 1
+	}
+
+	b := &Batcher[T]{
+		buffer:   make([]T, 0, maxSize),
+		maxSize:  maxSize,
+		maxDelay: maxDelay,
+		handler:  handler,
+		clock:    clock.RealClock{},
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// Add appends item to the current batch, flushing immediately if it is now full
+func (b *Batcher[T]) Add(item T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return ErrBatcherClosed
+	}
+
+	b.buffer = append(b.buffer, item)
+
+	if len(b.buffer) == 1 {
+		// First item of a new batch starts the delay clock
+		gen := b.generation
+		b.timer = b.clock.AfterFunc(b.maxDelay, func() {
+			b.flushGeneration(gen)
+		})
+	}
+
+	if len(b.buffer) >= b.maxSize {
+		b.flushLocked()
+	}
+
+	return nil
+}
+
+// Close flushes any buffered items and rejects further Adds
+// Calling Close more than once is safe
+func (b *Batcher[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.flushLocked()
+	b.closed = true
+}
+
+// flushGeneration is the timer callback; it only flushes the batch it was started for
+func (b *Batcher[T]) flushGeneration(gen int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if gen != b.generation {
+		return // Stale timer: that batch was already flushed
+	}
+
+	b.flushLocked()
+}
+
+// flushLocked hands the current buffer to the handler and starts a new batch
+// Caller must hold b.mu
+func (b *Batcher[T]) flushLocked() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	b.generation++
+
+	if len(b.buffer) == 0 {
+		return
+	}
+
+	batch := b.buffer
+	b.buffer = make([]T, 0, b.maxSize)
+	b.handler(batch)
+}
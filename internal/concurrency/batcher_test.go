@@ -0,0 +1,142 @@
+package concurrency
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/farhancdr/backend-interview-handbook/internal/clock"
+)
+
+// batchRecorder collects flushed batches
+type batchRecorder struct {
+	mu      sync.Mutex
+	batches [][]int
+}
+
+func (r *batchRecorder) Handle(batch []int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, batch)
+}
+
+func (r *batchRecorder) Batches() [][]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.batches
+}
+
+func TestBatcher_FullBatchFlushesImmediately(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	rec := &batchRecorder{}
+	b := NewBatcher(3, time.Minute, rec.Handle, WithBatcherClock[int](mock))
+
+	b.Add(1)
+	b.Add(2)
+	if len(rec.Batches()) != 0 {
+		t.Fatal("partial batch should not flush yet")
+	}
+
+	b.Add(3)
+
+	expected := [][]int{{1, 2, 3}}
+	if !reflect.DeepEqual(rec.Batches(), expected) {
+		t.Errorf("expected %v, got %v", expected, rec.Batches())
+	}
+
+	// The size flush stopped the delay timer, so reaching its deadline does nothing
+	mock.Advance(time.Minute)
+	if !reflect.DeepEqual(rec.Batches(), expected) {
+		t.Errorf("timer should be stopped after a size-triggered flush, got %v", rec.Batches())
+	}
+}
+
+func TestBatcher_PartialBatchFlushesAfterDelay(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	rec := &batchRecorder{}
+	b := NewBatcher(10, time.Second, rec.Handle, WithBatcherClock[int](mock))
+
+	b.Add(1)
+	b.Add(2)
+
+	mock.Advance(999 * time.Millisecond)
+	if len(rec.Batches()) != 0 {
+		t.Fatal("batch flushed before the delay elapsed")
+	}
+
+	mock.Advance(time.Millisecond)
+
+	expected := [][]int{{1, 2}}
+	if !reflect.DeepEqual(rec.Batches(), expected) {
+		t.Errorf("expected %v, got %v", expected, rec.Batches())
+	}
+}
+
+func TestBatcher_StaleTimerIgnored(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	rec := &batchRecorder{}
+	b := NewBatcher(2, time.Second, rec.Handle, WithBatcherClock[int](mock))
+
+	b.Add(1)
+	b.Add(2) // Size flush
+
+	b.Add(3) // Starts a new batch
+
+	// A real timer can fire just as the size flush stops it, leaving its callback waiting
+	// on the lock. Run that late callback for the first batch: it must not flush the second.
+	b.flushGeneration(0)
+
+	expected := [][]int{{1, 2}}
+	if !reflect.DeepEqual(rec.Batches(), expected) {
+		t.Errorf("expected %v, got %v", expected, rec.Batches())
+	}
+
+	mock.Advance(time.Second)
+	expected = [][]int{{1, 2}, {3}}
+	if !reflect.DeepEqual(rec.Batches(), expected) {
+		t.Errorf("expected %v, got %v", expected, rec.Batches())
+	}
+}
+
+func TestBatcher_CloseFlushesRemaining(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	rec := &batchRecorder{}
+	b := NewBatcher(10, time.Minute, rec.Handle, WithBatcherClock[int](mock))
+
+	b.Add(1)
+	b.Add(2)
+	b.Close()
+
+	expected := [][]int{{1, 2}}
+	if !reflect.DeepEqual(rec.Batches(), expected) {
+		t.Errorf("expected %v, got %v", expected, rec.Batches())
+	}
+
+	if err := b.Add(3); !errors.Is(err, ErrBatcherClosed) {
+		t.Errorf("expected ErrBatcherClosed, got %v", err)
+	}
+
+	b.Close() // Idempotent
+	mock.Advance(time.Minute)
+	if len(rec.Batches()) != 1 {
+		t.Error("second Close or the old timer should not flush again")
+	}
+}
+
+func TestBatcher_RealTimer(t *testing.T) {
+	flushed := make(chan []int, 1)
+	b := NewBatcher(100, time.Millisecond, func(batch []int) {
+		flushed <- batch
+	})
+	defer b.Close()
+
+	b.Add(42)
+
+	// Blocks until the real timer fires; no sleep needed
+	batch := <-flushed
+	if !reflect.DeepEqual(batch, []int{42}) {
+		t.Errorf("expected [42], got %v", batch)
+	}
+}
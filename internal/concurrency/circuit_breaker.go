@@ -0,0 +1,231 @@
+package concurrency
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/farhancdr/backend-interview-handbook/internal/clock"
+)
+
+// Why interviewers ask this:
+// When a dependency is down, retrying every request makes things worse: callers pile up
+// waiting on timeouts and the struggling service never gets room to recover. A circuit
+// breaker fails fast instead. It's a standard resilience question alongside retries,
+// timeouts, and rate limiting.
+//
+// internal/patterns/circuit_breaker.go introduces the pattern itself. This version is the
+// concurrency follow-up to it: it lets only one half-open trial through instead of every
+// caller, doesn't hold the lock while fn runs, and survives fn panicking. It also takes an
+// injectable clock and reports state changes.
+
+// Common pitfalls:
+// - Letting every caller through in half-open, so a recovering service gets a thundering herd
+// - Counting total failures instead of consecutive ones (one bad minute trips it forever)
+// - Holding the lock while calling the protected function (serializes all calls)
+// - Testing the cooldown with time.Sleep instead of an injectable clock
+// - Leaving the half-open trial marked in flight when fn panics, blocking every later call
+
+// Key takeaway:
+// Closed: calls pass through; N consecutive failures trip to Open. Open: calls fail
+// immediately with ErrCircuitOpen until the cooldown elapses. Half-open: exactly one trial
+// call is allowed - success closes the circuit, failure re-opens it for another cooldown.
+
+// ErrCircuitOpen is returned when the breaker rejects a call without running it
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// errCallPanicked records a panicking call as a failure; the panic itself is not swallowed
+var errCallPanicked = errors.New("circuit breaker: call panicked")
+
+// CircuitState is the state of a CircuitBreaker
+type CircuitState int
+
+const (
+	// CircuitClosed lets every call through and counts consecutive failures
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects every call with ErrCircuitOpen until the cooldown elapses
+	CircuitOpen
+	// CircuitHalfOpen lets a single trial call through to decide whether to close or re-open
+	CircuitHalfOpen
+)
+
+// String returns the state's name
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreaker stops calling a failing operation until it has had time to recover
+type CircuitBreaker struct {
+	mu               sync.Mutex
+	state            CircuitState
+	failureThreshold int
+	cooldown         time.Duration
+	failures         int       // Consecutive failures while closed
+	openedAt         time.Time // When the breaker last tripped
+	trialInFlight    bool      // A half-open trial call is running
+	clock            clock.Clock
+	onStateChange    func(from, to CircuitState)
+}
+
+// CircuitBreakerOption configures a CircuitBreaker
+type CircuitBreakerOption func(*CircuitBreaker)
+
+// WithCircuitBreakerClock replaces the real clock, letting tests control the cooldown
+func WithCircuitBreakerClock(c clock.Clock) CircuitBreakerOption {
+	return func(cb *CircuitBreaker) {
+		cb.clock = c
+	}
+}
+
+// WithCircuitBreakerStateChange registers a callback for every state transition
+// The callback runs with the breaker's lock held and must not call back into the breaker
+func WithCircuitBreakerStateChange(fn func(from, to CircuitState)) CircuitBreakerOption {
+	return func(cb *CircuitBreaker) {
+		cb.onStateChange = fn
+	}
+}
+
+// NewCircuitBreaker creates a closed breaker that opens after failureThreshold
+// consecutive failures and allows a trial call once cooldown has passed
+func NewCircuitBreaker(failureThreshold int, cooldown time.Duration, opts ...CircuitBreakerOption) *CircuitBreaker {
+	if failureThreshold < 1 {
+		failureThreshold = 1
+	}
+
+	cb := &CircuitBreaker{
+		state:            CircuitClosed,
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		clock:            clock.RealClock{},
+		onStateChange:    func(from, to CircuitState) {},
+	}
+
+	for _, opt := range opts {
+		opt(cb)
+	}
+
+	return cb
+}
+
+// Execute runs fn if the breaker allows it and records the outcome
+// Returns ErrCircuitOpen without running fn while the circuit is open, or while
+// another goroutine's half-open trial is in progress. If fn panics, the call counts as
+// a failure and the panic continues up to the caller.
+func (cb *CircuitBreaker) Execute(fn func() error) error {
+	trial, err := cb.beforeCall()
+	if err != nil {
+		return err
+	}
+
+	completed := false
+	defer func() {
+		if !completed {
+			// fn panicked: without this a half-open trial would stay in flight forever
+			cb.afterCall(trial, errCallPanicked)
+		}
+	}()
+
+	// fn runs without the lock so concurrent calls in the closed state don't serialize
+	err = fn()
+	completed = true
+	cb.afterCall(trial, err)
+	return err
+}
+
+// State returns the current state, moving Open to HalfOpen if the cooldown has elapsed
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.refreshLocked()
+	return cb.state
+}
+
+// beforeCall decides whether a call may proceed and whether it is the half-open trial
+func (cb *CircuitBreaker) beforeCall() (bool, error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.refreshLocked()
+
+	switch cb.state {
+	case CircuitOpen:
+		return false, ErrCircuitOpen
+	case CircuitHalfOpen:
+		if cb.trialInFlight {
+			return false, ErrCircuitOpen
+		}
+		cb.trialInFlight = true
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// afterCall records the outcome of a call that was allowed through
+func (cb *CircuitBreaker) afterCall(trial bool, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if trial {
+		cb.trialInFlight = false
+		if err != nil {
+			cb.tripLocked()
+		} else {
+			cb.failures = 0
+			cb.setStateLocked(CircuitClosed)
+		}
+		return
+	}
+
+	if cb.state != CircuitClosed {
+		return // Started before the circuit tripped; the outcome is stale
+	}
+
+	if err == nil {
+		cb.failures = 0
+		return
+	}
+
+	cb.failures++
+	if cb.failures >= cb.failureThreshold {
+		cb.tripLocked()
+	}
+}
+
+// refreshLocked moves Open to HalfOpen once the cooldown has elapsed
+// Caller must hold cb.mu
+func (cb *CircuitBreaker) refreshLocked() {
+	if cb.state == CircuitOpen && cb.clock.Now().Sub(cb.openedAt) >= cb.cooldown {
+		cb.setStateLocked(CircuitHalfOpen)
+	}
+}
+
+// tripLocked opens the circuit and starts the cooldown
+// Caller must hold cb.mu
+func (cb *CircuitBreaker) tripLocked() {
+	cb.openedAt = cb.clock.Now()
+	cb.failures = 0
+	cb.setStateLocked(CircuitOpen)
+}
+
+// setStateLocked changes state and notifies the callback
+// Caller must hold cb.mu
+func (cb *CircuitBreaker) setStateLocked(to CircuitState) {
+	from := cb.state
+	if from == to {
+		return
+	}
+
+	cb.state = to
+	cb.onStateChange(from, to)
+}
@@ -0,0 +1,174 @@
+package concurrency
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/farhancdr/backend-interview-handbook/internal/clock"
+)
+
+var errBackendDown = errors.New("backend down")
+
+func failing() error    { return errBackendDown }
+func succeeding() error { return nil }
+
+func TestCircuitBreaker_TripsAfterConsecutiveFailures(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	cb := NewCircuitBreaker(3, time.Second, WithCircuitBreakerClock(mock))
+
+	for i := 0; i < 2; i++ {
+		if err := cb.Execute(failing); err != errBackendDown {
+			t.Fatalf("call %d: expected backend error, got %v", i, err)
+		}
+	}
+	if cb.State() != CircuitClosed {
+		t.Fatalf("expected closed below threshold, got %s", cb.State())
+	}
+
+	cb.Execute(failing)
+	if cb.State() != CircuitOpen {
+		t.Fatalf("expected open after 3 failures, got %s", cb.State())
+	}
+}
+
+func TestCircuitBreaker_SuccessResetsFailureCount(t *testing.T) {
+	cb := NewCircuitBreaker(2, time.Second)
+
+	cb.Execute(failing)
+	cb.Execute(succeeding)
+	cb.Execute(failing)
+
+	if cb.State() != CircuitClosed {
+		t.Errorf("expected non-consecutive failures to keep circuit closed, got %s", cb.State())
+	}
+}
+
+func TestCircuitBreaker_ShortCircuitsWhileOpen(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	cb := NewCircuitBreaker(1, time.Second, WithCircuitBreakerClock(mock))
+	cb.Execute(failing)
+
+	calls := 0
+	mock.Advance(999 * time.Millisecond)
+	err := cb.Execute(func() error {
+		calls++
+		return nil
+	})
+
+	if err != ErrCircuitOpen || calls != 0 {
+		t.Errorf("expected ErrCircuitOpen without calling fn, got %v after %d calls", err, calls)
+	}
+}
+
+func TestCircuitBreaker_RecoversAfterSuccessfulTrial(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	var transitions []string
+	cb := NewCircuitBreaker(1, time.Second,
+		WithCircuitBreakerClock(mock),
+		WithCircuitBreakerStateChange(func(from, to CircuitState) {
+			transitions = append(transitions, from.String()+"->"+to.String())
+		}),
+	)
+
+	cb.Execute(failing)
+	mock.Advance(time.Second)
+
+	if cb.State() != CircuitHalfOpen {
+		t.Fatalf("expected half-open after cooldown, got %s", cb.State())
+	}
+	if err := cb.Execute(succeeding); err != nil {
+		t.Fatalf("expected trial call to run, got %v", err)
+	}
+	if cb.State() != CircuitClosed {
+		t.Fatalf("expected closed after successful trial, got %s", cb.State())
+	}
+
+	expected := []string{"closed->open", "open->half-open", "half-open->closed"}
+	if !reflect.DeepEqual(transitions, expected) {
+		t.Errorf("expected transitions %v, got %v", expected, transitions)
+	}
+}
+
+func TestCircuitBreaker_FailedTrialReopens(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	cb := NewCircuitBreaker(1, time.Second, WithCircuitBreakerClock(mock))
+
+	cb.Execute(failing)
+	mock.Advance(time.Second)
+	cb.Execute(failing) // Trial fails
+
+	if cb.State() != CircuitOpen {
+		t.Fatalf("expected open after failed trial, got %s", cb.State())
+	}
+
+	// A fresh cooldown starts from the failed trial
+	mock.Advance(500 * time.Millisecond)
+	if err := cb.Execute(succeeding); err != ErrCircuitOpen {
+		t.Errorf("expected ErrCircuitOpen during new cooldown, got %v", err)
+	}
+}
+
+func TestCircuitBreaker_PanickingTrialReopens(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	cb := NewCircuitBreaker(1, time.Second, WithCircuitBreakerClock(mock))
+	cb.Execute(failing)
+	mock.Advance(time.Second)
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("expected the trial's panic to reach the caller, got %v", r)
+			}
+		}()
+		cb.Execute(func() error { panic("boom") })
+	}()
+
+	if cb.State() != CircuitOpen {
+		t.Fatalf("expected a panicking trial to count as a failure, got %s", cb.State())
+	}
+
+	// The trial slot was released, so the next cooldown allows a new trial
+	mock.Advance(time.Second)
+	if err := cb.Execute(succeeding); err != nil {
+		t.Errorf("expected the next trial to run, got %v", err)
+	}
+	if cb.State() != CircuitClosed {
+		t.Errorf("expected closed after successful trial, got %s", cb.State())
+	}
+}
+
+func TestCircuitBreaker_SingleTrialInHalfOpen(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	cb := NewCircuitBreaker(1, time.Second, WithCircuitBreakerClock(mock))
+	cb.Execute(failing)
+	mock.Advance(time.Second)
+
+	trialStarted := make(chan struct{})
+	releaseTrial := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		cb.Execute(func() error {
+			close(trialStarted)
+			<-releaseTrial
+			return nil
+		})
+	}()
+
+	<-trialStarted
+	if err := cb.Execute(succeeding); err != ErrCircuitOpen {
+		t.Errorf("expected concurrent call during trial to be rejected, got %v", err)
+	}
+
+	close(releaseTrial)
+	wg.Wait()
+
+	if cb.State() != CircuitClosed {
+		t.Errorf("expected closed after trial completes, got %s", cb.State())
+	}
+}
@@ -0,0 +1,80 @@
+package concurrency
+
+import (
+	"context"
+	"sync"
+)
+
+// Why interviewers ask this:
+// A countdown latch (java.util.concurrent.CountDownLatch) is a one-shot gate: N events
+// must happen before any waiter proceeds. Interviewers ask how you'd build one in Go to
+// see whether you reach for a closed channel as a broadcast signal.
+
+// Common pitfalls:
+// - Using sync.WaitGroup, whose Add must happen before Wait and which cannot be
+//   waited on with a timeout or context
+// - Signalling waiters with a sync.Cond and forgetting the loop around Wait
+// - Closing the channel twice when CountDown is called more than count times
+// - Letting waiters block forever with no way to cancel
+
+// Key takeaway:
+// Closing a channel wakes every receiver at once and stays "open" for latecomers, which
+// is exactly latch semantics. Protect the counter with a mutex and close the channel
+// exactly once when it reaches zero. Wait selects on that channel and ctx.Done().
+
+// CountdownLatch releases all waiters once CountDown has been called count times
+type CountdownLatch struct {
+	mu    sync.Mutex
+	count int
+	done  chan struct{}
+}
+
+// NewCountdownLatch creates a latch that opens after count calls to CountDown
+// A count of zero or less creates an already-open latch
+func NewCountdownLatch(count int) *CountdownLatch {
+	l := &CountdownLatch{
+		count: count,
+		done:  make(chan struct{}),
+	}
+
+	if count <= 0 {
+		l.count = 0
+		close(l.done)
+	}
+
+	return l
+}
+
+// CountDown decrements the count and opens the latch when it reaches zero
+// Calls after the latch is open are no-ops
+func (l *CountdownLatch) CountDown() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.count == 0 {
+		return
+	}
+
+	l.count--
+	if l.count == 0 {
+		close(l.done) // Broadcast to every current and future waiter
+	}
+}
+
+// Wait blocks until the latch opens or ctx is done
+// Returns nil if the latch opened, or ctx.Err() if the context ended first
+func (l *CountdownLatch) Wait(ctx context.Context) error {
+	select {
+	case <-l.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Count returns the number of CountDown calls still needed
+func (l *CountdownLatch) Count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.count
+}
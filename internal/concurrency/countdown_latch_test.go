@@ -0,0 +1,97 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestCountdownLatch_ReleasesAllWaiters(t *testing.T) {
+	latch := NewCountdownLatch(3)
+
+	waiters := 5
+	var started, finished sync.WaitGroup
+	released := make(chan int, waiters)
+
+	for i := 0; i < waiters; i++ {
+		started.Add(1)
+		finished.Add(1)
+		go func(id int) {
+			defer finished.Done()
+			started.Done()
+			if err := latch.Wait(context.Background()); err == nil {
+				released <- id
+			}
+		}(i)
+	}
+	started.Wait()
+
+	latch.CountDown()
+	latch.CountDown()
+
+	if len(released) != 0 {
+		t.Fatal("waiters released before count reached zero")
+	}
+	if latch.Count() != 1 {
+		t.Errorf("expected count 1, got %d", latch.Count())
+	}
+
+	// The last CountDown releases everyone at once
+	latch.CountDown()
+	finished.Wait()
+
+	if len(released) != waiters {
+		t.Errorf("expected %d waiters released, got %d", waiters, len(released))
+	}
+}
+
+func TestCountdownLatch_LateWaiterPassesThrough(t *testing.T) {
+	latch := NewCountdownLatch(1)
+	latch.CountDown()
+
+	// Unlike a WaitGroup, joining after the count hit zero is fine
+	if err := latch.Wait(context.Background()); err != nil {
+		t.Errorf("expected nil error, got %v", err)
+	}
+}
+
+func TestCountdownLatch_ExtraCountDownIsNoop(t *testing.T) {
+	latch := NewCountdownLatch(1)
+
+	latch.CountDown()
+	latch.CountDown() // Must not panic on double close
+
+	if latch.Count() != 0 {
+		t.Errorf("expected count 0, got %d", latch.Count())
+	}
+}
+
+func TestCountdownLatch_ZeroCountIsOpen(t *testing.T) {
+	latch := NewCountdownLatch(0)
+
+	if err := latch.Wait(context.Background()); err != nil {
+		t.Errorf("expected open latch, got %v", err)
+	}
+}
+
+func TestCountdownLatch_Cancelled(t *testing.T) {
+	latch := NewCountdownLatch(2)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- latch.Wait(ctx)
+	}()
+
+	latch.CountDown() // Only one of two
+	cancel()
+
+	err := <-errCh
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if latch.Count() != 1 {
+		t.Errorf("cancellation should not change count, got %d", latch.Count())
+	}
+}
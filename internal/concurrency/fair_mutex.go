@@ -0,0 +1,98 @@
+package concurrency
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// Why interviewers ask this:
+// sync.Mutex makes no FIFO promise: a goroutine that just unlocked can grab the lock again
+// before a long-waiting goroutine wakes up (Go only switches to a fair "starvation mode"
+// after a waiter has waited 1ms). Building a fair lock shows you understand that fairness
+// and throughput trade off, and how hand-off between goroutines works.
+
+// Common pitfalls:
+// - Waking all waiters on unlock and letting them race (thundering herd, no ordering)
+// - Releasing the lock and then signalling, so a newcomer can barge in between
+// - A cancelled waiter that was granted the lock at the same moment, leaking it forever
+// - O(n) removal of cancelled waiters from a slice-based queue
+
+// Key takeaway:
+// Keep a FIFO queue of per-waiter channels. Unlock hands ownership directly to the head
+// waiter by closing its channel - the lock never becomes free in between, so nobody can
+// barge. On cancellation, check whether the grant already happened; if so, pass it on.
+// Fairness costs throughput: every hand-off requires a context switch.
+
+// FairMutex is a mutual exclusion lock granted to waiters in arrival order
+type FairMutex struct {
+	mu      sync.Mutex
+	locked  bool
+	waiters *list.List // FIFO of chan struct{}, closed when that waiter is granted the lock
+}
+
+// NewFairMutex creates an unlocked FairMutex
+func NewFairMutex() *FairMutex {
+	return &FairMutex{waiters: list.New()}
+}
+
+// Lock acquires the mutex, waiting behind earlier callers
+// Returns ctx.Err() if ctx is done before the lock is granted; the mutex is not held then
+func (m *FairMutex) Lock(ctx context.Context) error {
+	m.mu.Lock()
+	if !m.locked && m.waiters.Len() == 0 {
+		m.locked = true
+		m.mu.Unlock()
+		return nil
+	}
+
+	granted := make(chan struct{})
+	elem := m.waiters.PushBack(granted)
+	m.mu.Unlock()
+
+	select {
+	case <-granted:
+		return nil
+	case <-ctx.Done():
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	select {
+	case <-granted:
+		// Unlock handed us the lock just as ctx was cancelled; pass it on
+		m.handOffLocked()
+	default:
+		m.waiters.Remove(elem)
+	}
+
+	return ctx.Err()
+}
+
+// Unlock releases the mutex, handing it to the longest-waiting caller if there is one
+// Unlocking an unlocked FairMutex panics, like sync.Mutex
+func (m *FairMutex) Unlock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.locked {
+		panic("concurrency: unlock of unlocked FairMutex")
+	}
+
+	m.handOffLocked()
+}
+
+// handOffLocked transfers ownership to the next waiter, or marks the mutex free
+// Caller must hold m.mu
+func (m *FairMutex) handOffLocked() {
+	front := m.waiters.Front()
+	if front == nil {
+		m.locked = false
+		return
+	}
+
+	// locked stays true: ownership moves directly to the waiter
+	m.waiters.Remove(front)
+	close(front.Value.(chan struct{}))
+}
@@ -0,0 +1,120 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// waitForWaiters blocks until n goroutines are queued on m
+func waitForWaiters(t *testing.T, m *FairMutex, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+
+	for time.Now().Before(deadline) {
+		m.mu.Lock()
+		queued := m.waiters.Len()
+		m.mu.Unlock()
+
+		if queued == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d waiters", n)
+}
+
+func TestFairMutex_GrantsInArrivalOrder(t *testing.T) {
+	m := NewFairMutex()
+	m.Lock(context.Background())
+
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			m.Lock(context.Background())
+			mu.Lock()
+			order = append(order, id)
+			mu.Unlock()
+			m.Unlock()
+		}(i)
+
+		// Ensure goroutine i is queued before starting i+1
+		waitForWaiters(t, m, i+1)
+	}
+
+	m.Unlock()
+	wg.Wait()
+
+	expected := []int{0, 1, 2, 3, 4}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("expected FIFO order %v, got %v", expected, order)
+	}
+}
+
+func TestFairMutex_CancelWhileWaiting(t *testing.T) {
+	m := NewFairMutex()
+	m.Lock(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- m.Lock(ctx)
+	}()
+
+	waitForWaiters(t, m, 1)
+	cancel()
+
+	if err := <-errCh; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	waitForWaiters(t, m, 0)
+
+	// The cancelled waiter must not hold or block the lock
+	m.Unlock()
+	timeout, cancelTimeout := context.WithTimeout(context.Background(), time.Second)
+	defer cancelTimeout()
+	if err := m.Lock(timeout); err != nil {
+		t.Errorf("expected lock to be free after cancelled waiter left, got %v", err)
+	}
+}
+
+func TestFairMutex_MutualExclusion(t *testing.T) {
+	m := NewFairMutex()
+	counter := 0
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				m.Lock(context.Background())
+				counter++
+				m.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if counter != 2000 {
+		t.Errorf("expected 2000, got %d", counter)
+	}
+}
+
+func TestFairMutex_UnlockOfUnlockedPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic when unlocking an unlocked FairMutex")
+		}
+	}()
+
+	NewFairMutex().Unlock()
+}
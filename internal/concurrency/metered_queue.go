@@ -0,0 +1,105 @@
+package concurrency
+
+import "sync/atomic"
+
+// Why interviewers ask this:
+// Bounded channels provide backpressure, but in production you need to see it: how deep
+// is the queue, how close did it get to full, are consumers keeping up? Instrumenting a
+// queue with atomics is a common "how would you observe this?" follow-up.
+
+// Common pitfalls:
+// - Protecting counters with the same mutex the hot path uses (adds contention)
+// - Tracking depth with a separate counter that can go negative between send and receive
+// - Reading several atomics and assuming the snapshot is globally consistent
+// - Updating a max with a plain compare-then-store (races); use a CAS loop
+
+// Key takeaway:
+// Use atomic counters for enqueue/dequeue totals and len(ch) for the current depth,
+// which the runtime keeps exact. Track the high-water mark with a CompareAndSwap loop.
+// At quiescence: enqueued == dequeued + depth.
+
+// QueueStats is a point-in-time snapshot of a MeteredQueue's counters
+// Fields are read individually, so a snapshot taken under load is approximate
+type QueueStats struct {
+	Enqueued int64
+	Dequeued int64
+	Depth    int
+	MaxDepth int64
+	Capacity int
+}
+
+// MeteredQueue is a bounded FIFO queue backed by a channel that records usage metrics
+type MeteredQueue[T any] struct {
+	items    chan T
+	enqueued atomic.Int64
+	dequeued atomic.Int64
+	maxDepth atomic.Int64
+}
+
+// NewMeteredQueue creates a queue holding at most capacity items
+func NewMeteredQueue[T any](capacity int) *MeteredQueue[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &MeteredQueue[T]{
+		items: make(chan T, capacity),
+	}
+}
+
+// Enqueue adds item, blocking while the queue is full
+func (q *MeteredQueue[T]) Enqueue(item T) {
+	q.items <- item
+	q.enqueued.Add(1)
+	q.observeDepth(int64(len(q.items)))
+}
+
+// TryEnqueue adds item without blocking
+// Returns false if the queue is full
+func (q *MeteredQueue[T]) TryEnqueue(item T) bool {
+	select {
+	case q.items <- item:
+		q.enqueued.Add(1)
+		q.observeDepth(int64(len(q.items)))
+		return true
+	default:
+		return false
+	}
+}
+
+// Dequeue removes and returns the oldest item, blocking while the queue is empty
+// Returns the zero value and false once the queue is closed and drained
+func (q *MeteredQueue[T]) Dequeue() (T, bool) {
+	item, ok := <-q.items
+	if ok {
+		q.dequeued.Add(1)
+	}
+	return item, ok
+}
+
+// Close signals that no more items will be enqueued
+// Enqueue after Close panics, just like sending on a closed channel
+func (q *MeteredQueue[T]) Close() {
+	close(q.items)
+}
+
+// Stats returns a snapshot of the queue's metrics
+func (q *MeteredQueue[T]) Stats() QueueStats {
+	return QueueStats{
+		Enqueued: q.enqueued.Load(),
+		Dequeued: q.dequeued.Load(),
+		Depth:    len(q.items),
+		MaxDepth: q.maxDepth.Load(),
+		Capacity: cap(q.items),
+	}
+}
+
+// observeDepth raises the high-water mark if depth exceeds it
+func (q *MeteredQueue[T]) observeDepth(depth int64) {
+	for {
+		current := q.maxDepth.Load()
+		if depth <= current || q.maxDepth.CompareAndSwap(current, depth) {
+			return
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package concurrency
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestMeteredQueue_FIFOAndStats(t *testing.T) {
+	q := NewMeteredQueue[string](3)
+
+	q.Enqueue("a")
+	q.Enqueue("b")
+	q.Enqueue("c")
+
+	if q.TryEnqueue("d") {
+		t.Error("TryEnqueue on a full queue should fail")
+	}
+
+	first, _ := q.Dequeue()
+	second, _ := q.Dequeue()
+	if first != "a" || second != "b" {
+		t.Errorf("expected FIFO order a, b; got %s, %s", first, second)
+	}
+
+	stats := q.Stats()
+	expected := QueueStats{Enqueued: 3, Dequeued: 2, Depth: 1, MaxDepth: 3, Capacity: 3}
+	if stats != expected {
+		t.Errorf("expected %+v, got %+v", expected, stats)
+	}
+}
+
+func TestMeteredQueue_DequeueAfterClose(t *testing.T) {
+	q := NewMeteredQueue[int](2)
+	q.Enqueue(1)
+	q.Close()
+
+	if v, ok := q.Dequeue(); !ok || v != 1 {
+		t.Errorf("expected buffered item 1, got %d, %v", v, ok)
+	}
+	if _, ok := q.Dequeue(); ok {
+		t.Error("expected false after queue is closed and drained")
+	}
+}
+
+func TestMeteredQueue_ConcurrentCountsBalance(t *testing.T) {
+	q := NewMeteredQueue[int](16)
+
+	producers, perProducer := 8, 500
+	consumers, perConsumer := 5, 798 // Leaves 10 items, which fit in the buffer
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				q.Enqueue(i)
+			}
+		}()
+	}
+
+	var consumersWg sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		consumersWg.Add(1)
+		go func() {
+			defer consumersWg.Done()
+			for i := 0; i < perConsumer; i++ {
+				q.Dequeue()
+			}
+		}()
+	}
+
+	wg.Wait()
+	consumersWg.Wait()
+
+	stats := q.Stats()
+	if stats.Enqueued != int64(producers*perProducer) {
+		t.Errorf("expected %d enqueued, got %d", producers*perProducer, stats.Enqueued)
+	}
+	if stats.Dequeued != int64(consumers*perConsumer) {
+		t.Errorf("expected %d dequeued, got %d", consumers*perConsumer, stats.Dequeued)
+	}
+	if stats.Enqueued != stats.Dequeued+int64(stats.Depth) {
+		t.Errorf("enqueued (%d) != dequeued (%d) + depth (%d)", stats.Enqueued, stats.Dequeued, stats.Depth)
+	}
+	if stats.MaxDepth > int64(stats.Capacity) || stats.MaxDepth < int64(stats.Depth) {
+		t.Errorf("max depth %d out of range (depth %d, capacity %d)", stats.MaxDepth, stats.Depth, stats.Capacity)
+	}
+}
@@ -0,0 +1,77 @@
+package concurrency
+
+import (
+	"context"
+	"sync"
+)
+
+// Why interviewers ask this:
+// "Process these items in parallel but keep the output in order" is a common follow-up
+// to worker pools. A flatMap variant adds a twist: each input expands into zero or more
+// outputs, so you cannot preallocate a single result slot per item in the final slice.
+
+// Common pitfalls:
+// - Appending to a shared slice from workers (data race and random order)
+// - Collecting results from a channel and losing the input order
+// - Ignoring ctx and continuing to dispatch work after cancellation
+// - Spawning one goroutine per item instead of bounding concurrency
+
+// Key takeaway:
+// Give each input index its own result slot ([][]R). Workers write only to their own
+// slot, so no lock is needed, and concatenating the slots in index order after
+// wg.Wait() restores input order regardless of completion order.
+
+// ParallelFlatMap applies fn to every item using at most workers goroutines and
+// concatenates the outputs in input order. If ctx is cancelled, no new items are
+// started and only the outputs of items that already ran are returned (still in order).
+func ParallelFlatMap[T, R any](ctx context.Context, items []T, workers int, fn func(T) []R) []R {
+	if workers < 1 {
+		workers = 1
+	}
+
+	// One slot per input keeps ordering without any locking
+	slots := make([][]R, len(items))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				// Re-check before doing work: an item may have been handed
+				// out just as ctx was cancelled
+				if ctx.Err() != nil {
+					continue
+				}
+				slots[i] = fn(items[i])
+			}
+		}()
+	}
+
+dispatch:
+	for i := range items {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	total := 0
+	for _, slot := range slots {
+		total += len(slot)
+	}
+
+	result := make([]R, 0, total)
+	for _, slot := range slots {
+		result = append(result, slot...)
+	}
+
+	return result
+}
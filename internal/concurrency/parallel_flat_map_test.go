@@ -0,0 +1,100 @@
+package concurrency
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestParallelFlatMap_PreservesOrder(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+
+	result := ParallelFlatMap(context.Background(), items, 3, func(n int) []int {
+		// Expand n into n copies of itself
+		out := make([]int, n)
+		for i := range out {
+			out[i] = n
+		}
+		return out
+	})
+
+	expected := []int{1, 2, 2, 3, 3, 3, 4, 4, 4, 4, 5, 5, 5, 5, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestParallelFlatMap_OutOfOrderCompletion(t *testing.T) {
+	items := []string{"first", "second", "third"}
+	thirdDone := make(chan struct{})
+
+	result := ParallelFlatMap(context.Background(), items, 3, func(s string) []string {
+		switch s {
+		case "first":
+			// Force the first item to finish after the last one
+			<-thirdDone
+		case "third":
+			defer close(thirdDone)
+		}
+		return []string{s + "-a", s + "-b"}
+	})
+
+	expected := []string{"first-a", "first-b", "second-a", "second-b", "third-a", "third-b"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestParallelFlatMap_EmptyExpansion(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+
+	// Keep only even numbers by returning nil for odd ones
+	result := ParallelFlatMap(context.Background(), items, 2, func(n int) []int {
+		if n%2 != 0 {
+			return nil
+		}
+		return []int{n}
+	})
+
+	expected := []int{2, 4}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestParallelFlatMap_AlreadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	result := ParallelFlatMap(ctx, []int{1, 2, 3}, 2, func(n int) []int {
+		called = true
+		return []int{n}
+	})
+
+	if called {
+		t.Error("fn should not run when ctx is already cancelled")
+	}
+	if len(result) != 0 {
+		t.Errorf("expected no results, got %v", result)
+	}
+}
+
+func TestParallelFlatMap_CancelMidway(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// With a single worker items run sequentially, so cancelling
+	// inside the first call stops everything after it
+	result := ParallelFlatMap(ctx, []int{1, 2, 3, 4}, 1, func(n int) []int {
+		if n == 1 {
+			cancel()
+		}
+		return []int{n * 10}
+	})
+
+	expected := []int{10}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
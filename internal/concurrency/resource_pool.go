@@ -0,0 +1,120 @@
+package concurrency
+
+import (
+	"context"
+)
+
+// Why interviewers ask this:
+// "Design a connection pool" is a staple: a fixed number of expensive resources (DB
+// connections, gRPC channels) shared by many goroutines. sync.Pool is the wrong tool -
+// it may drop objects at any GC and has no upper bound - so you build a bounded pool with
+// blocking acquire, cancellation, and a way to weed out broken resources.
+
+// Common pitfalls:
+// - Using sync.Pool for connections (no size limit, objects vanish on GC, nothing gets closed)
+// - Acquire that can't be cancelled, so callers hang forever when the pool is exhausted
+// - Handing out a dead resource without checking it first
+// - Losing a slot when creating a replacement fails, so the pool slowly shrinks to zero
+
+// Key takeaway:
+// Separate "permission to hold a resource" from "an idle resource": a semaphore of size n
+// bounds how many are in use, and a buffered channel holds idle ones. Acquire takes a slot
+// (selecting on ctx.Done()), reuses an idle resource if it passes its health check, and
+// otherwise creates a new one. On failure the slot is returned, so capacity never leaks.
+
+// ResourcePool is a bounded pool of reusable resources created on demand
+type ResourcePool[T any] struct {
+	slots       chan struct{} // One token per resource that may be in use
+	idle        chan T        // Released resources waiting to be reused
+	factory     func() (T, error)
+	healthCheck func(T) error
+	discard     func(T)
+}
+
+// ResourcePoolOption configures a ResourcePool
+type ResourcePoolOption[T any] func(*ResourcePool[T])
+
+// WithResourcePoolHealthCheck sets a check run on an idle resource before it is handed out
+// A resource that fails the check is discarded and replaced with a new one from the factory
+func WithResourcePoolHealthCheck[T any](check func(T) error) ResourcePoolOption[T] {
+	return func(p *ResourcePool[T]) {
+		p.healthCheck = check
+	}
+}
+
+// WithResourcePoolDiscard sets a function called on resources that fail their health check,
+// typically to close them
+func WithResourcePoolDiscard[T any](discard func(T)) ResourcePoolOption[T] {
+	return func(p *ResourcePool[T]) {
+		p.discard = discard
+	}
+}
+
+// NewResourcePool creates a pool holding at most size resources, built lazily by factory
+func NewResourcePool[T any](size int, factory func() (T, error), opts ...ResourcePoolOption[T]) *ResourcePool[T] {
+	if size < 1 {
+		size = 1
+	}
+
+	p := &ResourcePool[T]{
+		slots:       make(chan struct{}, size),
+		idle:        make(chan T, size),
+		factory:     factory,
+		healthCheck: func(T) error { return nil },
+		discard:     func(T) {},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Acquire returns a resource, blocking until one is free or ctx is done
+// An idle resource is reused if it passes the health check; otherwise a new one is created
+// Every successful Acquire must be paired with a Release
+func (p *ResourcePool[T]) Acquire(ctx context.Context) (T, error) {
+	var zero T
+
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+
+	select {
+	case res := <-p.idle:
+		if err := p.healthCheck(res); err == nil {
+			return res, nil
+		}
+		p.discard(res)
+	default:
+		// No idle resource yet: this slot has never been filled
+	}
+
+	res, err := p.factory()
+	if err != nil {
+		<-p.slots // Give the slot back so capacity isn't lost
+		return zero, err
+	}
+
+	return res, nil
+}
+
+// Release returns a resource obtained from Acquire to the pool
+func (p *ResourcePool[T]) Release(res T) {
+	// Never blocks: at most size resources are out, and idle has room for size
+	p.idle <- res
+	<-p.slots
+}
+
+// InUse returns the number of resources currently acquired
+func (p *ResourcePool[T]) InUse() int {
+	return len(p.slots)
+}
+
+// Idle returns the number of released resources waiting to be reused
+func (p *ResourcePool[T]) Idle() int {
+	return len(p.idle)
+}
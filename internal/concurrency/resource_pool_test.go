@@ -0,0 +1,160 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type pooledConn struct {
+	id      int
+	healthy bool
+}
+
+func TestResourcePool_ReusesReleasedResource(t *testing.T) {
+	created := 0
+	pool := NewResourcePool(2, func() (*pooledConn, error) {
+		created++
+		return &pooledConn{id: created, healthy: true}, nil
+	})
+
+	conn, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pool.Release(conn)
+
+	again, _ := pool.Acquire(context.Background())
+	if again != conn || created != 1 {
+		t.Errorf("expected released conn %d to be reused, got conn %d after %d creations", conn.id, again.id, created)
+	}
+}
+
+func TestResourcePool_BoundsConcurrentUse(t *testing.T) {
+	const size = 3
+
+	var created, inUse, maxInUse atomic.Int64
+	pool := NewResourcePool(size, func() (int, error) {
+		return int(created.Add(1)), nil
+	})
+
+	var wg sync.WaitGroup
+	for g := 0; g < 20; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				res, err := pool.Acquire(context.Background())
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				current := inUse.Add(1)
+				for {
+					seen := maxInUse.Load()
+					if current <= seen || maxInUse.CompareAndSwap(seen, current) {
+						break
+					}
+				}
+				inUse.Add(-1)
+
+				pool.Release(res)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInUse.Load() > size {
+		t.Errorf("expected at most %d resources in use, saw %d", size, maxInUse.Load())
+	}
+	if created.Load() > size {
+		t.Errorf("expected at most %d resources created, got %d", size, created.Load())
+	}
+	if pool.InUse() != 0 {
+		t.Errorf("expected nothing in use after all releases, got %d", pool.InUse())
+	}
+}
+
+func TestResourcePool_AcquireRespectsContext(t *testing.T) {
+	pool := NewResourcePool(1, func() (string, error) {
+		return "conn", nil
+	})
+
+	held, _ := pool.Acquire(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := pool.Acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded, got %v", err)
+	}
+
+	pool.Release(held)
+	if _, err := pool.Acquire(context.Background()); err != nil {
+		t.Errorf("expected acquire to succeed after release, got %v", err)
+	}
+}
+
+func TestResourcePool_UnhealthyResourceIsReplaced(t *testing.T) {
+	created := 0
+	var discarded []int
+
+	pool := NewResourcePool(1,
+		func() (*pooledConn, error) {
+			created++
+			return &pooledConn{id: created, healthy: true}, nil
+		},
+		WithResourcePoolHealthCheck(func(c *pooledConn) error {
+			if !c.healthy {
+				return errors.New("connection reset")
+			}
+			return nil
+		}),
+		WithResourcePoolDiscard(func(c *pooledConn) {
+			discarded = append(discarded, c.id)
+		}),
+	)
+
+	conn, _ := pool.Acquire(context.Background())
+	conn.healthy = false // Breaks while in use
+	pool.Release(conn)
+
+	replacement, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if replacement.id != 2 || !replacement.healthy {
+		t.Errorf("expected fresh conn 2, got conn %d (healthy=%v)", replacement.id, replacement.healthy)
+	}
+	if len(discarded) != 1 || discarded[0] != 1 {
+		t.Errorf("expected conn 1 to be discarded, got %v", discarded)
+	}
+}
+
+func TestResourcePool_FactoryErrorReturnsSlot(t *testing.T) {
+	fail := true
+	pool := NewResourcePool(1, func() (int, error) {
+		if fail {
+			return 0, errors.New("dial failed")
+		}
+		return 1, nil
+	})
+
+	if _, err := pool.Acquire(context.Background()); err == nil {
+		t.Fatal("expected factory error")
+	}
+	if pool.InUse() != 0 {
+		t.Errorf("expected failed acquire to free its slot, got %d in use", pool.InUse())
+	}
+
+	fail = false
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if res, err := pool.Acquire(ctx); err != nil || res != 1 {
+		t.Errorf("expected acquire to succeed once factory recovers, got %d, %v", res, err)
+	}
+}
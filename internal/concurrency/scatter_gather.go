@@ -0,0 +1,65 @@
+package concurrency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Why interviewers ask this:
+// A search page fans out to ten backends and renders whatever comes back in time. That is
+// scatter-gather: run everything concurrently, give each call its own deadline, and return
+// partial results instead of failing the whole request because one shard is slow.
+
+// Common pitfalls:
+// - One shared timeout for all tasks, so a single slow task decides for everyone
+// - Returning on the first error (errgroup style) when partial results are acceptable
+// - Collecting results from a channel and losing which task produced which result
+// - Waiting on a task that ignores ctx, so the "timeout" never actually fires
+
+// Key takeaway:
+// Each task gets a child context with its own timeout and writes only to its own index,
+// so results[i] and errs[i] always describe tasks[i]. Select on the task's completion and
+// its deadline, so a task that ignores ctx still can't hold up the gather.
+
+// ScatterGather runs every task concurrently, each with its own timeout derived from ctx,
+// and returns results and errors indexed by task position. A task that fails or times out
+// leaves the zero value in results and a non-nil error in errs; the others are unaffected.
+// Returns once every task has finished or hit its deadline.
+func ScatterGather[R any](ctx context.Context, tasks []func(context.Context) (R, error), perTaskTimeout time.Duration) ([]R, []error) {
+	results := make([]R, len(tasks))
+	errs := make([]error, len(tasks))
+
+	var wg sync.WaitGroup
+	for i, task := range tasks {
+		wg.Add(1)
+		go func(i int, task func(context.Context) (R, error)) {
+			defer wg.Done()
+
+			taskCtx, cancel := context.WithTimeout(ctx, perTaskTimeout)
+			defer cancel()
+
+			type outcome struct {
+				result R
+				err    error
+			}
+			// Buffered so an abandoned task can still send and exit
+			done := make(chan outcome, 1)
+
+			go func() {
+				result, err := task(taskCtx)
+				done <- outcome{result, err}
+			}()
+
+			select {
+			case o := <-done:
+				results[i], errs[i] = o.result, o.err
+			case <-taskCtx.Done():
+				errs[i] = taskCtx.Err()
+			}
+		}(i, task)
+	}
+
+	wg.Wait()
+	return results, errs
+}
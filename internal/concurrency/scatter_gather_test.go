@@ -0,0 +1,99 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestScatterGather_PartialResultsLineUpByIndex(t *testing.T) {
+	errShardDown := errors.New("shard down")
+
+	tasks := []func(context.Context) (string, error){
+		func(ctx context.Context) (string, error) { return "a", nil },
+		func(ctx context.Context) (string, error) { return "", errShardDown },
+		func(ctx context.Context) (string, error) {
+			// Cooperative slow task: honours cancellation
+			select {
+			case <-time.After(time.Second):
+				return "too late", nil
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+		},
+		func(ctx context.Context) (string, error) {
+			time.Sleep(5 * time.Millisecond)
+			return "d", nil
+		},
+	}
+
+	results, errs := ScatterGather(context.Background(), tasks, 50*time.Millisecond)
+
+	expectedResults := []string{"a", "", "", "d"}
+	if !reflect.DeepEqual(results, expectedResults) {
+		t.Errorf("expected results %q, got %q", expectedResults, results)
+	}
+
+	if errs[0] != nil || errs[3] != nil {
+		t.Errorf("expected successful tasks to have nil errors, got %v", errs)
+	}
+	if !errors.Is(errs[1], errShardDown) {
+		t.Errorf("expected task 1 error %v, got %v", errShardDown, errs[1])
+	}
+	if !errors.Is(errs[2], context.DeadlineExceeded) {
+		t.Errorf("expected task 2 to time out, got %v", errs[2])
+	}
+}
+
+func TestScatterGather_UncooperativeTaskStillTimesOut(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	tasks := []func(context.Context) (int, error){
+		func(ctx context.Context) (int, error) {
+			<-release // Ignores ctx entirely
+			return 1, nil
+		},
+		func(ctx context.Context) (int, error) { return 2, nil },
+	}
+
+	start := time.Now()
+	results, errs := ScatterGather(context.Background(), tasks, 20*time.Millisecond)
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected gather to return near the timeout, took %v", elapsed)
+	}
+	if !errors.Is(errs[0], context.DeadlineExceeded) || results[0] != 0 {
+		t.Errorf("expected task 0 to time out with zero result, got %d, %v", results[0], errs[0])
+	}
+	if errs[1] != nil || results[1] != 2 {
+		t.Errorf("expected task 1 to succeed with 2, got %d, %v", results[1], errs[1])
+	}
+}
+
+func TestScatterGather_ParentCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tasks := []func(context.Context) (int, error){
+		func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		},
+	}
+
+	_, errs := ScatterGather(ctx, tasks, time.Second)
+	if !errors.Is(errs[0], context.Canceled) {
+		t.Errorf("expected context.Canceled from parent, got %v", errs[0])
+	}
+}
+
+func TestScatterGather_NoTasks(t *testing.T) {
+	results, errs := ScatterGather[int](context.Background(), nil, time.Second)
+
+	if len(results) != 0 || len(errs) != 0 {
+		t.Errorf("expected empty slices, got %v, %v", results, errs)
+	}
+}
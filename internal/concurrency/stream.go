@@ -0,0 +1,153 @@
+package concurrency
+
+import "sync"
+
+// Why interviewers ask this:
+// Pipelines of goroutines connected by channels are idiomatic Go, but hand-wiring each
+// stage (see ChannelPipeline) repeats the same boilerplate and usually forgets early exit.
+// A small stream API shows you can generalize the pattern with generics and, crucially,
+// tear down every upstream goroutine when a downstream stage stops reading.
+
+// Common pitfalls:
+// - Take returning early while upstream stages stay blocked on send forever (goroutine leak)
+// - Starting goroutines when the stream is built rather than when it is consumed
+// - Closing a channel from the receiving side to signal "stop" (panics the sender)
+// - Infinite sources that never check a done channel
+
+// Key takeaway:
+// Building a stream only composes functions; Collect starts every stage at once. Every
+// send selects on a shared done channel, so when Collect finishes (for example because
+// Take stopped reading) it closes done and waits for all stages to exit - nothing leaks.
+
+// streamRun is the shared state of one execution of a stream
+type streamRun struct {
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// spawn runs fn as a pipeline stage tracked by the run
+func (r *streamRun) spawn(fn func()) {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		fn()
+	}()
+}
+
+// send delivers v on out unless the run is being torn down
+func send[T any](r *streamRun, out chan<- T, v T) bool {
+	select {
+	case out <- v:
+		return true
+	case <-r.done:
+		return false
+	}
+}
+
+// Stream is a lazy sequence of values; no goroutines run until Collect is called
+type Stream[T any] struct {
+	start func(r *streamRun) <-chan T
+}
+
+// FromSlice creates a stream of the given items
+func FromSlice[T any](items []T) *Stream[T] {
+	return &Stream[T]{start: func(r *streamRun) <-chan T {
+		out := make(chan T)
+		r.spawn(func() {
+			defer close(out)
+			for _, item := range items {
+				if !send(r, out, item) {
+					return
+				}
+			}
+		})
+		return out
+	}}
+}
+
+// Iterate creates an infinite stream seed, next(seed), next(next(seed)), ...
+// Use Take to bound it
+func Iterate[T any](seed T, next func(T) T) *Stream[T] {
+	return &Stream[T]{start: func(r *streamRun) <-chan T {
+		out := make(chan T)
+		r.spawn(func() {
+			defer close(out)
+			v := seed
+			for send(r, out, v) {
+				v = next(v)
+			}
+		})
+		return out
+	}}
+}
+
+// MapStream returns a stream applying fn to every value of s
+func MapStream[T, R any](s *Stream[T], fn func(T) R) *Stream[R] {
+	return &Stream[R]{start: func(r *streamRun) <-chan R {
+		in := s.start(r)
+		out := make(chan R)
+		r.spawn(func() {
+			defer close(out)
+			for v := range in {
+				if !send(r, out, fn(v)) {
+					return
+				}
+			}
+		})
+		return out
+	}}
+}
+
+// FilterStream returns a stream of the values of s for which keep returns true
+func FilterStream[T any](s *Stream[T], keep func(T) bool) *Stream[T] {
+	return &Stream[T]{start: func(r *streamRun) <-chan T {
+		in := s.start(r)
+		out := make(chan T)
+		r.spawn(func() {
+			defer close(out)
+			for v := range in {
+				if keep(v) && !send(r, out, v) {
+					return
+				}
+			}
+		})
+		return out
+	}}
+}
+
+// Take returns a stream of at most the first n values of s
+// Once n values are taken it stops reading; upstream stages are stopped by Collect
+func (s *Stream[T]) Take(n int) *Stream[T] {
+	return &Stream[T]{start: func(r *streamRun) <-chan T {
+		in := s.start(r)
+		out := make(chan T)
+		r.spawn(func() {
+			defer close(out)
+			for taken := 0; taken < n; taken++ {
+				v, ok := <-in
+				if !ok || !send(r, out, v) {
+					return
+				}
+			}
+		})
+		return out
+	}}
+}
+
+// Collect runs the stream and returns all its values in order
+// Every stage goroutine has exited by the time Collect returns
+func (s *Stream[T]) Collect() []T {
+	r := &streamRun{done: make(chan struct{})}
+	out := s.start(r)
+
+	result := []T{}
+	for v := range out {
+		result = append(result, v)
+	}
+
+	// Unblock any upstream stage still trying to send, then wait for all to exit
+	close(r.done)
+	r.wg.Wait()
+
+	return result
+}
@@ -0,0 +1,85 @@
+package concurrency
+
+import (
+	"reflect"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestStream_FilterMapTake(t *testing.T) {
+	evens := FilterStream(FromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8}), func(n int) bool {
+		return n%2 == 0
+	})
+	labels := MapStream(evens, func(n int) string {
+		return "#" + strconv.Itoa(n*n)
+	})
+
+	result := labels.Take(3).Collect()
+
+	expected := []string{"#4", "#16", "#36"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestStream_IsLazy(t *testing.T) {
+	var calls atomic.Int64
+	s := MapStream(FromSlice([]int{1, 2, 3}), func(n int) int {
+		calls.Add(1)
+		return n
+	})
+
+	if calls.Load() != 0 {
+		t.Fatalf("expected no work before Collect, got %d calls", calls.Load())
+	}
+
+	s.Collect()
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 calls after Collect, got %d", calls.Load())
+	}
+}
+
+func TestStream_TakeStopsInfiniteProducer(t *testing.T) {
+	var produced atomic.Int64
+	naturals := Iterate(1, func(n int) int {
+		produced.Add(1)
+		return n + 1
+	})
+
+	before := runtime.NumGoroutine()
+	result := MapStream(naturals, func(n int) int { return n * 10 }).Take(5).Collect()
+	after := runtime.NumGoroutine()
+
+	expected := []int{10, 20, 30, 40, 50}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	// Unbuffered stages let the producer run at most a couple of values ahead
+	if produced.Load() > 8 {
+		t.Errorf("expected producer to stop shortly after 5 values, produced %d", produced.Load())
+	}
+	if after > before {
+		t.Errorf("goroutine leak: %d before Collect, %d after", before, after)
+	}
+}
+
+func TestStream_TakeMoreThanAvailable(t *testing.T) {
+	result := FromSlice([]string{"a", "b"}).Take(10).Collect()
+
+	expected := []string{"a", "b"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestStream_TakeZeroAndEmpty(t *testing.T) {
+	if result := Iterate(0, func(n int) int { return n + 1 }).Take(0).Collect(); len(result) != 0 {
+		t.Errorf("expected empty result for Take(0), got %v", result)
+	}
+	if result := FromSlice([]int{}).Collect(); len(result) != 0 {
+		t.Errorf("expected empty result for empty source, got %v", result)
+	}
+}
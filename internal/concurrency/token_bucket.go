@@ -0,0 +1,117 @@
+package concurrency
+
+import (
+	"sync"
+	"time"
+
+	"github.com/farhancdr/backend-interview-handbook/internal/clock"
+)
+
+// Why interviewers ask this:
+// Token buckets are the standard answer to "rate limit this API". The non-blocking
+// Allow form is what HTTP middleware uses: reject with 429 instead of queueing. Making
+// the clock injectable shows you know how to test time-dependent code without sleeping.
+//
+// internal/system_design/rate_limiter.go introduces the same algorithm. This version is
+// the testable follow-up: it takes a clock.Clock and validates AllowN's argument.
+
+// Common pitfalls:
+// - Refilling with a background ticker goroutine (leaks, drift) instead of lazily
+// - Using wall-clock arithmetic that jumps when the system clock changes
+// - Letting tokens exceed capacity after a long idle period
+// - Testing with time.Sleep, which is slow and flaky
+// - Accepting n <= 0 in AllowN: a negative n passes the check and mints tokens
+
+// Key takeaway:
+// Refill lazily on each call: tokens = min(capacity, tokens + elapsed * rate). time.Now()
+// carries a monotonic reading, so Sub is immune to wall-clock jumps. Accept a clock.Clock
+// so tests can advance time deterministically with clock.MockClock.
+
+// TokenBucket is a non-blocking, thread-safe token bucket rate limiter
+// Capacity is the maximum burst; refillRate is tokens added per second
+type TokenBucket struct {
+	mu         sync.Mutex
+	capacity   float64
+	tokens     float64
+	refillRate float64
+	lastRefill time.Time
+	clock      clock.Clock
+}
+
+// TokenBucketOption configures a TokenBucket
+type TokenBucketOption func(*TokenBucket)
+
+// WithTokenBucketClock replaces the real clock, letting tests control elapsed time
+func WithTokenBucketClock(c clock.Clock) TokenBucketOption {
+	return func(tb *TokenBucket) {
+		tb.clock = c
+	}
+}
+
+// NewTokenBucket creates a bucket that starts full
+func NewTokenBucket(capacity int, refillRate float64, opts ...TokenBucketOption) *TokenBucket {
+	tb := &TokenBucket{
+		capacity:   float64(capacity),
+		tokens:     float64(capacity),
+		refillRate: refillRate,
+		clock:      clock.RealClock{},
+	}
+
+	for _, opt := range opts {
+		opt(tb)
+	}
+
+	tb.lastRefill = tb.clock.Now()
+	return tb
+}
+
+// Allow reports whether one token was available and consumes it if so
+func (tb *TokenBucket) Allow() bool {
+	return tb.AllowN(1)
+}
+
+// AllowN reports whether n tokens were available and consumes them if so
+// Returns false for n <= 0. Requests larger than capacity can never succeed, since the
+// bucket never holds more than capacity tokens.
+func (tb *TokenBucket) AllowN(n int) bool {
+	if n <= 0 {
+		return false // Negative n would add tokens; zero would "succeed" without limiting anything
+	}
+
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+
+	tb.refill()
+
+	if tb.tokens >= float64(n) {
+		tb.tokens -= float64(n)
+		return true
+	}
+
+	return false
+}
+
+// Tokens returns the current number of available tokens (after refilling)
+func (tb *TokenBucket) Tokens() float64 {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+
+	tb.refill()
+	return tb.tokens
+}
+
+// refill lazily adds tokens for the time elapsed since the last refill
+// Caller must hold tb.mu
+func (tb *TokenBucket) refill() {
+	now := tb.clock.Now()
+	elapsed := now.Sub(tb.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	tb.tokens += elapsed * tb.refillRate
+	if tb.tokens > tb.capacity {
+		tb.tokens = tb.capacity
+	}
+	tb.lastRefill = now
+}
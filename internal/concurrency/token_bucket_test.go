@@ -0,0 +1,115 @@
+package concurrency
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/farhancdr/backend-interview-handbook/internal/clock"
+)
+
+func TestTokenBucket_BurstThenRefill(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	tb := NewTokenBucket(5, 1, WithTokenBucketClock(mock))
+
+	// A burst of `capacity` requests succeeds
+	for i := 0; i < 5; i++ {
+		if !tb.Allow() {
+			t.Fatalf("expected request %d to be allowed", i)
+		}
+	}
+
+	// The next one fails
+	if tb.Allow() {
+		t.Error("expected request to be denied when bucket is empty")
+	}
+
+	// After one refill interval a single request succeeds again
+	mock.Advance(time.Second)
+	if !tb.Allow() {
+		t.Error("expected request to be allowed after refill")
+	}
+	if tb.Allow() {
+		t.Error("expected only one token to have been refilled")
+	}
+}
+
+func TestTokenBucket_RefillCapsAtCapacity(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	tb := NewTokenBucket(3, 10, WithTokenBucketClock(mock))
+
+	tb.AllowN(3)
+	mock.Advance(time.Hour)
+
+	if tb.Tokens() != 3 {
+		t.Errorf("expected tokens capped at 3, got %v", tb.Tokens())
+	}
+}
+
+func TestTokenBucket_AllowN(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	tb := NewTokenBucket(10, 2, WithTokenBucketClock(mock))
+
+	if !tb.AllowN(7) {
+		t.Error("expected AllowN(7) to succeed")
+	}
+	if tb.AllowN(4) {
+		t.Error("expected AllowN(4) to fail with 3 tokens left")
+	}
+
+	// Failed request must not consume tokens
+	if tb.Tokens() != 3 {
+		t.Errorf("expected 3 tokens, got %v", tb.Tokens())
+	}
+
+	mock.Advance(500 * time.Millisecond) // +1 token
+	if !tb.AllowN(4) {
+		t.Error("expected AllowN(4) to succeed after partial refill")
+	}
+
+	if tb.AllowN(11) {
+		t.Error("request larger than capacity should never succeed")
+	}
+}
+
+func TestTokenBucket_AllowNRejectsNonPositive(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	tb := NewTokenBucket(10, 1, WithTokenBucketClock(mock))
+	tb.AllowN(10) // Drain the bucket
+
+	if tb.AllowN(-5) {
+		t.Error("AllowN(-5) should be rejected")
+	}
+	if tb.AllowN(0) {
+		t.Error("AllowN(0) should be rejected")
+	}
+	if tb.Tokens() != 0 {
+		t.Errorf("rejected requests must not change the bucket, got %v tokens", tb.Tokens())
+	}
+}
+
+func TestTokenBucket_Concurrent(t *testing.T) {
+	mock := clock.NewMockClock(time.Unix(0, 0))
+	tb := NewTokenBucket(100, 1, WithTokenBucketClock(mock))
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	allowed := 0
+
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if tb.Allow() {
+				mu.Lock()
+				allowed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if allowed != 100 {
+		t.Errorf("expected exactly 100 allowed, got %d", allowed)
+	}
+}
@@ -0,0 +1,59 @@
+package concurrency
+
+import (
+	"context"
+	"reflect"
+)
+
+// Why interviewers ask this:
+// A select statement has a fixed number of cases decided at compile time. Interviewers
+// ask how you would wait on N channels where N is only known at runtime, which tests
+// whether you know reflect.Select (or the fan-in alternative) and its trade-offs.
+
+// Common pitfalls:
+// - Trying to build a select statement in a loop (not possible)
+// - Treating a closed channel as a real value (recvOK is false on close)
+// - Forgetting the cancellation case and blocking forever
+// - Using reflect.Select in a hot path; it is much slower than a static select
+// - Asserting recv.Interface().(T) without comma-ok: panics on nil when T is an interface
+
+// Key takeaway:
+// reflect.Select takes a []reflect.SelectCase and returns the chosen index, the value,
+// and whether it was a real receive. Put ctx.Done() in the case list so cancellation
+// competes fairly with the data channels. Disable closed channels by setting their
+// case to a nil channel, exactly like the nil-channel trick in a static select.
+
+// WaitAny blocks until one of chans produces a value and returns that value together
+// with the index of the channel it came from. Closed channels are ignored. If ctx is
+// cancelled first, or every channel is closed, it returns the zero value, -1 and false.
+func WaitAny[T any](ctx context.Context, chans ...<-chan T) (value T, index int, ok bool) {
+	// Case 0 is cancellation; case i+1 is chans[i]
+	cases := make([]reflect.SelectCase, len(chans)+1)
+	cases[0] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())}
+	for i, ch := range chans {
+		cases[i+1] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)}
+	}
+
+	open := len(chans)
+	for open > 0 {
+		chosen, recv, recvOK := reflect.Select(cases)
+
+		if chosen == 0 {
+			return value, -1, false // Context cancelled
+		}
+
+		if !recvOK {
+			// Closed channel: a nil channel blocks forever, which disables the case
+			cases[chosen].Chan = reflect.Value{}
+			open--
+			continue
+		}
+
+		// Comma-ok: a nil interface value (e.g. a nil error) fails the assertion and
+		// comes back as T's zero value instead of panicking
+		v, _ := recv.Interface().(T)
+		return v, chosen - 1, true
+	}
+
+	return value, -1, false
+}
@@ -0,0 +1,118 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestWaitAny_ReturnsFirstReady(t *testing.T) {
+	chans := make([]chan string, 4)
+	for i := range chans {
+		chans[i] = make(chan string, 1)
+	}
+
+	// Only channel 2 has a value ready
+	chans[2] <- "third"
+
+	value, index, ok := WaitAny(context.Background(), recvOnly(chans)...)
+
+	if !ok {
+		t.Fatal("expected ok=true")
+	}
+	if index != 2 || value != "third" {
+		t.Errorf("expected (third, 2), got (%s, %d)", value, index)
+	}
+}
+
+func TestWaitAny_ValueFromGoroutine(t *testing.T) {
+	a := make(chan int)
+	b := make(chan int)
+
+	go func() {
+		b <- 42
+	}()
+
+	value, index, ok := WaitAny(context.Background(), a, b)
+
+	if !ok || index != 1 || value != 42 {
+		t.Errorf("expected (42, 1, true), got (%d, %d, %v)", value, index, ok)
+	}
+}
+
+func TestWaitAny_NilInterfaceValue(t *testing.T) {
+	results := make(chan error, 1)
+	results <- nil // A successful operation reporting no error
+
+	failures := make(chan error, 1)
+
+	err, index, ok := WaitAny(context.Background(), results, failures)
+
+	if !ok || index != 0 || err != nil {
+		t.Errorf("expected (nil, 0, true), got (%v, %d, %v)", err, index, ok)
+	}
+
+	failures <- errors.New("boom")
+	err, index, ok = WaitAny(context.Background(), results, failures)
+	if !ok || index != 1 || err == nil || err.Error() != "boom" {
+		t.Errorf("expected (boom, 1, true), got (%v, %d, %v)", err, index, ok)
+	}
+}
+
+func TestWaitAny_SkipsClosedChannels(t *testing.T) {
+	closed := make(chan int)
+	close(closed)
+
+	ready := make(chan int, 1)
+	ready <- 7
+
+	value, index, ok := WaitAny(context.Background(), closed, ready)
+
+	if !ok || index != 1 || value != 7 {
+		t.Errorf("expected (7, 1, true), got (%d, %d, %v)", value, index, ok)
+	}
+}
+
+func TestWaitAny_AllClosed(t *testing.T) {
+	a := make(chan int)
+	b := make(chan int)
+	close(a)
+	close(b)
+
+	_, index, ok := WaitAny(context.Background(), a, b)
+
+	if ok || index != -1 {
+		t.Errorf("expected (-1, false), got (%d, %v)", index, ok)
+	}
+}
+
+func TestWaitAny_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Nobody ever sends on these channels
+	a := make(chan int)
+	b := make(chan int)
+
+	done := make(chan struct{})
+	var ok bool
+	var index int
+	go func() {
+		_, index, ok = WaitAny(ctx, a, b)
+		close(done)
+	}()
+
+	cancel()
+	<-done
+
+	if ok || index != -1 {
+		t.Errorf("expected (-1, false) after cancel, got (%d, %v)", index, ok)
+	}
+}
+
+func recvOnly[T any](chans []chan T) []<-chan T {
+	result := make([]<-chan T, len(chans))
+	for i, ch := range chans {
+		result[i] = ch
+	}
+	return result
+}
@@ -0,0 +1,215 @@
+package ds
+
+// Why interviewers ask this:
+// A plain BST turns into a linked list on sorted input, so every operation becomes O(n).
+// AVL trees are the classic fix and the usual follow-up to "what's the worst case of a BST?".
+// Interviewers want to see the four rotation cases and where heights get updated.
+
+// Common pitfalls:
+// - Updating a node's height after rotating its parent instead of before
+// - Mixing up the left-right and right-left cases (they need two rotations)
+// - Rebalancing only on insert; a delete can unbalance every ancestor up to the root
+// - Recomputing heights recursively instead of caching them on the node
+
+// Key takeaway:
+// Each node caches its height; balance factor = height(left) - height(right) must stay in
+// [-1, 1]. After every insert or delete, walk back up and rotate where it doesn't.
+// Height is then at most ~1.44 log2(n), so search/insert/delete are O(log n) guaranteed.
+
+// avlNode is a tree node that caches the height of its subtree
+type avlNode struct {
+	Value  int
+	Height int // Leaf has height 0
+	Left   *avlNode
+	Right  *avlNode
+}
+
+// AVLTree is a self-balancing binary search tree
+// Time Complexity: O(log n) for search/insert/delete in the worst case
+// Space Complexity: O(n) for n nodes
+type AVLTree struct {
+	root *avlNode
+	size int
+}
+
+// NewAVLTree creates a new empty AVL tree
+func NewAVLTree() *AVLTree {
+	return &AVLTree{}
+}
+
+func avlHeight(node *avlNode) int {
+	if node == nil {
+		return -1
+	}
+	return node.Height
+}
+
+func (n *avlNode) updateHeight() {
+	n.Height = 1 + max(avlHeight(n.Left), avlHeight(n.Right))
+}
+
+func (n *avlNode) balanceFactor() int {
+	return avlHeight(n.Left) - avlHeight(n.Right)
+}
+
+// rotateRight lifts the left child above node
+//
+//	    y            x
+//	   / \          / \
+//	  x   C  ->    A   y
+//	 / \              / \
+//	A   B            B   C
+func rotateRight(y *avlNode) *avlNode {
+	x := y.Left
+	y.Left = x.Right
+	x.Right = y
+
+	y.updateHeight() // y is now below x, so update it first
+	x.updateHeight()
+	return x
+}
+
+// rotateLeft lifts the right child above node (mirror of rotateRight)
+func rotateLeft(x *avlNode) *avlNode {
+	y := x.Right
+	x.Right = y.Left
+	y.Left = x
+
+	x.updateHeight()
+	y.updateHeight()
+	return y
+}
+
+// rebalance restores the AVL property at node and returns the new subtree root
+func rebalance(node *avlNode) *avlNode {
+	node.updateHeight()
+	balance := node.balanceFactor()
+
+	if balance > 1 {
+		if node.Left.balanceFactor() < 0 {
+			node.Left = rotateLeft(node.Left) // Left-right case
+		}
+		return rotateRight(node) // Left-left case
+	}
+
+	if balance < -1 {
+		if node.Right.balanceFactor() > 0 {
+			node.Right = rotateRight(node.Right) // Right-left case
+		}
+		return rotateLeft(node) // Right-right case
+	}
+
+	return node
+}
+
+// Insert adds a value, rebalancing on the way back up
+// Duplicates are not inserted
+// Time Complexity: O(log n)
+func (t *AVLTree) Insert(value int) {
+	t.root = t.insertHelper(t.root, value)
+}
+
+func (t *AVLTree) insertHelper(node *avlNode, value int) *avlNode {
+	if node == nil {
+		t.size++
+		return &avlNode{Value: value}
+	}
+
+	if value < node.Value {
+		node.Left = t.insertHelper(node.Left, value)
+	} else if value > node.Value {
+		node.Right = t.insertHelper(node.Right, value)
+	} else {
+		return node // No duplicates
+	}
+
+	return rebalance(node)
+}
+
+// Delete removes a value, rebalancing every ancestor on the way back up
+// Returns true if value was found and deleted
+// Time Complexity: O(log n)
+func (t *AVLTree) Delete(value int) bool {
+	before := t.size
+	t.root = t.deleteHelper(t.root, value)
+	return t.size < before
+}
+
+func (t *AVLTree) deleteHelper(node *avlNode, value int) *avlNode {
+	if node == nil {
+		return nil
+	}
+
+	if value < node.Value {
+		node.Left = t.deleteHelper(node.Left, value)
+	} else if value > node.Value {
+		node.Right = t.deleteHelper(node.Right, value)
+	} else {
+		if node.Left == nil || node.Right == nil {
+			t.size--
+			if node.Left != nil {
+				return node.Left
+			}
+			return node.Right
+		}
+
+		// Two children: copy the inorder successor, then delete it from the right subtree
+		successor := node.Right
+		for successor.Left != nil {
+			successor = successor.Left
+		}
+		node.Value = successor.Value
+		node.Right = t.deleteHelper(node.Right, successor.Value)
+	}
+
+	return rebalance(node)
+}
+
+// Search checks if a value exists in the tree
+// Time Complexity: O(log n)
+func (t *AVLTree) Search(value int) bool {
+	current := t.root
+	for current != nil {
+		if value == current.Value {
+			return true
+		} else if value < current.Value {
+			current = current.Left
+		} else {
+			current = current.Right
+		}
+	}
+	return false
+}
+
+// InorderTraversal returns values in sorted order
+// Time Complexity: O(n)
+func (t *AVLTree) InorderTraversal() []int {
+	result := []int{}
+	var walk func(node *avlNode)
+	walk = func(node *avlNode) {
+		if node == nil {
+			return
+		}
+		walk(node.Left)
+		result = append(result, node.Value)
+		walk(node.Right)
+	}
+	walk(t.root)
+	return result
+}
+
+// Height returns the height of the tree (-1 if empty, 0 for a single node)
+// Time Complexity: O(1) since heights are cached
+func (t *AVLTree) Height() int {
+	return avlHeight(t.root)
+}
+
+// Size returns the number of values stored
+func (t *AVLTree) Size() int {
+	return t.size
+}
+
+// IsEmpty returns true if the tree has no nodes
+func (t *AVLTree) IsEmpty() bool {
+	return t.root == nil
+}
@@ -0,0 +1,159 @@
+package ds
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// assertAVL checks BST ordering, cached heights and balance factors for every node
+func assertAVL(t *testing.T, node *avlNode, low, high int) int {
+	t.Helper()
+	if node == nil {
+		return -1
+	}
+	if node.Value <= low || node.Value >= high {
+		t.Fatalf("node %d violates BST bounds (%d, %d)", node.Value, low, high)
+	}
+
+	left := assertAVL(t, node.Left, low, node.Value)
+	right := assertAVL(t, node.Right, node.Value, high)
+
+	if node.Height != 1+max(left, right) {
+		t.Fatalf("node %d: cached height %d, actual %d", node.Value, node.Height, 1+max(left, right))
+	}
+	if diff := left - right; diff < -1 || diff > 1 {
+		t.Fatalf("node %d: balance factor %d out of range", node.Value, diff)
+	}
+	return node.Height
+}
+
+func TestAVLTree_SortedInsertStaysBalanced(t *testing.T) {
+	avl := NewAVLTree()
+	bst := NewBST()
+	n := 1000
+
+	for i := 1; i <= n; i++ {
+		avl.Insert(i)
+		if i <= 100 {
+			bst.Insert(i) // A plain BST degenerates into a list
+		}
+	}
+
+	limit := int(1.44 * math.Log2(float64(n)))
+	if avl.Height() > limit {
+		t.Errorf("expected height <= %d for %d sorted inserts, got %d", limit, n, avl.Height())
+	}
+	if bst.Height() != 99 {
+		t.Errorf("expected plain BST height 99 for 100 sorted inserts, got %d", bst.Height())
+	}
+	if avl.Size() != n {
+		t.Errorf("expected size %d, got %d", n, avl.Size())
+	}
+	assertAVL(t, avl.root, math.MinInt, math.MaxInt)
+}
+
+func TestAVLTree_RotationCases(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+	}{
+		{"left-left", []int{3, 2, 1}},
+		{"right-right", []int{1, 2, 3}},
+		{"left-right", []int{3, 1, 2}},
+		{"right-left", []int{1, 3, 2}},
+	}
+
+	for _, tt := range tests {
+		avl := NewAVLTree()
+		for _, v := range tt.values {
+			avl.Insert(v)
+		}
+
+		if avl.root.Value != 2 || avl.Height() != 1 {
+			t.Errorf("%s: expected root 2 with height 1, got root %d height %d", tt.name, avl.root.Value, avl.Height())
+		}
+	}
+}
+
+func TestAVLTree_SearchAndDuplicates(t *testing.T) {
+	avl := NewAVLTree()
+	for _, v := range []int{10, 5, 15, 5, 10} {
+		avl.Insert(v)
+	}
+
+	if avl.Size() != 3 {
+		t.Errorf("duplicates should not be inserted, expected size 3, got %d", avl.Size())
+	}
+	if !avl.Search(15) || avl.Search(7) {
+		t.Error("search returned wrong result")
+	}
+}
+
+func TestAVLTree_Delete(t *testing.T) {
+	avl := NewAVLTree()
+	for i := 1; i <= 15; i++ {
+		avl.Insert(i)
+	}
+
+	// Leaf, one child, two children (root) and a missing value
+	for _, v := range []int{1, 2, 8, 12} {
+		if !avl.Delete(v) {
+			t.Errorf("Delete(%d): expected success", v)
+		}
+		assertAVL(t, avl.root, math.MinInt, math.MaxInt)
+	}
+	if avl.Delete(100) {
+		t.Error("delete non-existent value should fail")
+	}
+
+	expected := []int{3, 4, 5, 6, 7, 9, 10, 11, 13, 14, 15}
+	if !reflect.DeepEqual(avl.InorderTraversal(), expected) {
+		t.Errorf("expected %v, got %v", expected, avl.InorderTraversal())
+	}
+	if avl.Size() != len(expected) {
+		t.Errorf("expected size %d, got %d", len(expected), avl.Size())
+	}
+}
+
+func TestAVLTree_RandomOperations(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	avl := NewAVLTree()
+	present := make(map[int]bool)
+
+	for i := 0; i < 2000; i++ {
+		v := rng.Intn(300)
+		if rng.Intn(3) == 0 {
+			if avl.Delete(v) != present[v] {
+				t.Fatalf("Delete(%d): expected %v", v, present[v])
+			}
+			delete(present, v)
+		} else {
+			avl.Insert(v)
+			present[v] = true
+		}
+	}
+
+	assertAVL(t, avl.root, math.MinInt, math.MaxInt)
+	if avl.Size() != len(present) {
+		t.Errorf("expected size %d, got %d", len(present), avl.Size())
+	}
+	inorder := avl.InorderTraversal()
+	for i := 1; i < len(inorder); i++ {
+		if inorder[i] <= inorder[i-1] {
+			t.Fatal("inorder traversal should be sorted")
+		}
+	}
+}
+
+func TestAVLTree_Empty(t *testing.T) {
+	avl := NewAVLTree()
+
+	if !avl.IsEmpty() || avl.Height() != -1 || avl.Size() != 0 {
+		t.Error("new AVL tree should be empty with height -1")
+	}
+	if avl.Delete(1) || avl.Search(1) {
+		t.Error("operations on empty tree should fail")
+	}
+}
@@ -1,5 +1,10 @@
 package ds
 
+import (
+	"strconv"
+	"strings"
+)
+
 // Why interviewers ask this:
 // Binary trees are fundamental to understanding hierarchical data structures and tree traversal
 // algorithms. They're the basis for BSTs, heaps, and many other structures. Interviewers test
@@ -74,6 +79,55 @@ func (bt *BinaryTree) Insert(value int) {
 	}
 }
 
+// Delete removes the first node (in level order) holding value
+// The deepest, rightmost node's value replaces the target's and that node is removed,
+// so the tree stays complete just like after Insert
+// Returns false if value is not in the tree
+// Time Complexity: O(n), Space Complexity: O(w) where w is the max width
+func (bt *BinaryTree) Delete(value int) bool {
+	if bt.Root == nil {
+		return false
+	}
+
+	var target, last, lastParent *TreeNode
+	queue := []*TreeNode{bt.Root}
+
+	// The final node dequeued in level order is the deepest, rightmost one
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if target == nil && current.Value == value {
+			target = current
+		}
+		if current.Left != nil {
+			lastParent = current
+			queue = append(queue, current.Left)
+		}
+		if current.Right != nil {
+			lastParent = current
+			queue = append(queue, current.Right)
+		}
+		last = current
+	}
+
+	if target == nil {
+		return false
+	}
+
+	target.Value = last.Value
+
+	if lastParent == nil {
+		bt.Root = nil // last was the root, the only node
+	} else if lastParent.Right == last {
+		lastParent.Right = nil
+	} else {
+		lastParent.Left = nil
+	}
+
+	return true
+}
+
 // InorderTraversal returns values in inorder (Left-Root-Right)
 // Time Complexity: O(n), Space Complexity: O(h) where h is height
 func (bt *BinaryTree) InorderTraversal() []int {
@@ -156,6 +210,130 @@ func (bt *BinaryTree) LevelOrderTraversal() []int {
 	return result
 }
 
+// LevelOrderByLevel returns one slice of values per depth, root level first
+// Snapshotting the queue length at the start of each round separates the levels
+// Time Complexity: O(n), Space Complexity: O(w) where w is max width
+func (bt *BinaryTree) LevelOrderByLevel() [][]int {
+	levels := [][]int{}
+
+	if bt.Root == nil {
+		return levels
+	}
+
+	queue := []*TreeNode{bt.Root}
+
+	for len(queue) > 0 {
+		levelSize := len(queue) // Exactly the nodes at the current depth
+		level := make([]int, 0, levelSize)
+
+		for i := 0; i < levelSize; i++ {
+			current := queue[0]
+			queue = queue[1:]
+
+			level = append(level, current.Value)
+
+			if current.Left != nil {
+				queue = append(queue, current.Left)
+			}
+			if current.Right != nil {
+				queue = append(queue, current.Right)
+			}
+		}
+
+		levels = append(levels, level)
+	}
+
+	return levels
+}
+
+// ZigzagLevelOrder returns per-level values alternating direction:
+// left-to-right on even depths, right-to-left on odd depths
+// The BFS order is unchanged; each value is written straight into its mirrored slot
+// Time Complexity: O(n), Space Complexity: O(w) where w is max width
+func (bt *BinaryTree) ZigzagLevelOrder() [][]int {
+	levels := [][]int{}
+
+	if bt.Root == nil {
+		return levels
+	}
+
+	queue := []*TreeNode{bt.Root}
+	leftToRight := true
+
+	for len(queue) > 0 {
+		levelSize := len(queue)
+		level := make([]int, levelSize)
+
+		for i := 0; i < levelSize; i++ {
+			current := queue[0]
+			queue = queue[1:]
+
+			if leftToRight {
+				level[i] = current.Value
+			} else {
+				level[levelSize-1-i] = current.Value
+			}
+
+			if current.Left != nil {
+				queue = append(queue, current.Left)
+			}
+			if current.Right != nil {
+				queue = append(queue, current.Right)
+			}
+		}
+
+		levels = append(levels, level)
+		leftToRight = !leftToRight
+	}
+
+	return levels
+}
+
+// RightSideView returns the last node at each depth, as seen from the right of the tree
+// The visible node may come from the left subtree when the right one is shallower
+// Time Complexity: O(n), Space Complexity: O(w) where w is max width
+func (bt *BinaryTree) RightSideView() []int {
+	return bt.sideView(false)
+}
+
+// LeftSideView returns the first node at each depth, as seen from the left of the tree
+// Time Complexity: O(n), Space Complexity: O(w) where w is max width
+func (bt *BinaryTree) LeftSideView() []int {
+	return bt.sideView(true)
+}
+
+func (bt *BinaryTree) sideView(first bool) []int {
+	view := []int{}
+
+	if bt.Root == nil {
+		return view
+	}
+
+	queue := []*TreeNode{bt.Root}
+
+	for len(queue) > 0 {
+		levelSize := len(queue)
+
+		for i := 0; i < levelSize; i++ {
+			current := queue[0]
+			queue = queue[1:]
+
+			if (first && i == 0) || (!first && i == levelSize-1) {
+				view = append(view, current.Value)
+			}
+
+			if current.Left != nil {
+				queue = append(queue, current.Left)
+			}
+			if current.Right != nil {
+				queue = append(queue, current.Right)
+			}
+		}
+	}
+
+	return view
+}
+
 // Height returns the height of the tree (longest path from root to leaf)
 // Height of empty tree is -1, single node is 0
 // Time Complexity: O(n)
@@ -177,6 +355,154 @@ func (bt *BinaryTree) heightHelper(node *TreeNode) int {
 	return rightHeight + 1
 }
 
+// IsBalanced reports whether every node's subtrees differ in height by at most 1
+// A single post-order pass returns heights upward and stops at the first imbalance
+// Time Complexity: O(n), Space Complexity: O(h)
+func (bt *BinaryTree) IsBalanced() bool {
+	_, balanced := bt.balancedHeight(bt.Root)
+	return balanced
+}
+
+func (bt *BinaryTree) balancedHeight(node *TreeNode) (int, bool) {
+	if node == nil {
+		return -1, true
+	}
+
+	leftHeight, ok := bt.balancedHeight(node.Left)
+	if !ok {
+		return 0, false
+	}
+	rightHeight, ok := bt.balancedHeight(node.Right)
+	if !ok {
+		return 0, false
+	}
+
+	if leftHeight-rightHeight > 1 || rightHeight-leftHeight > 1 {
+		return 0, false
+	}
+	return max(leftHeight, rightHeight) + 1, true
+}
+
+// Diameter returns the number of edges on the longest path between any two nodes
+// The path need not pass through the root, so every node is tried as the turning point
+// Time Complexity: O(n), Space Complexity: O(h)
+func (bt *BinaryTree) Diameter() int {
+	diameter := 0
+	bt.diameterHeight(bt.Root, &diameter)
+	return diameter
+}
+
+func (bt *BinaryTree) diameterHeight(node *TreeNode, diameter *int) int {
+	if node == nil {
+		return -1
+	}
+
+	leftHeight := bt.diameterHeight(node.Left, diameter)
+	rightHeight := bt.diameterHeight(node.Right, diameter)
+
+	// Longest path bending at node: down each side, plus the two edges to the children
+	*diameter = max(*diameter, leftHeight+rightHeight+2)
+
+	return max(leftHeight, rightHeight) + 1
+}
+
+// Invert mirrors the tree in place by swapping every node's left and right children
+// Inorder traversal of the result is the reverse of the original's
+// Time Complexity: O(n), Space Complexity: O(h)
+func (bt *BinaryTree) Invert() {
+	invertHelper(bt.Root)
+}
+
+func invertHelper(node *TreeNode) {
+	if node == nil {
+		return
+	}
+	node.Left, node.Right = node.Right, node.Left
+	invertHelper(node.Left)
+	invertHelper(node.Right)
+}
+
+// InvertedCopy returns a mirrored copy of the tree, leaving the original untouched
+// Time Complexity: O(n), Space Complexity: O(n)
+func (bt *BinaryTree) InvertedCopy() *BinaryTree {
+	return &BinaryTree{Root: mirrorCopy(bt.Root)}
+}
+
+func mirrorCopy(node *TreeNode) *TreeNode {
+	if node == nil {
+		return nil
+	}
+	return &TreeNode{
+		Value: node.Value,
+		Left:  mirrorCopy(node.Right),
+		Right: mirrorCopy(node.Left),
+	}
+}
+
+// nullMarker stands in for a missing child in the serialized form
+const nullMarker = "#"
+
+// Serialize encodes the tree as comma-separated preorder values with "#" for nil children
+// Example: 1 with left child 2 encodes as "1,2,#,#,#"; the empty tree is "#"
+// Time Complexity: O(n), Space Complexity: O(n)
+func (bt *BinaryTree) Serialize() string {
+	parts := []string{}
+	serializeHelper(bt.Root, &parts)
+	return strings.Join(parts, ",")
+}
+
+func serializeHelper(node *TreeNode, parts *[]string) {
+	if node == nil {
+		*parts = append(*parts, nullMarker)
+		return
+	}
+	*parts = append(*parts, strconv.Itoa(node.Value))
+	serializeHelper(node.Left, parts)
+	serializeHelper(node.Right, parts)
+}
+
+// Deserialize rebuilds a tree produced by Serialize
+// Null markers make preorder alone unambiguous, so no second traversal is needed
+// Returns nil if s is malformed (bad value, too few or too many tokens). That includes
+// "": an empty tree serializes as "#", so Serialize never produces an empty string.
+// Time Complexity: O(n), Space Complexity: O(n)
+func Deserialize(s string) *BinaryTree {
+	tokens := strings.Split(s, ",")
+	pos := 0
+	ok := true
+
+	var build func() *TreeNode
+	build = func() *TreeNode {
+		if pos >= len(tokens) {
+			ok = false // Ran out of tokens mid-tree
+			return nil
+		}
+		token := tokens[pos]
+		pos++
+
+		if token == nullMarker {
+			return nil
+		}
+		value, err := strconv.Atoi(token)
+		if err != nil {
+			ok = false
+			return nil
+		}
+
+		node := NewTreeNode(value)
+		node.Left = build()
+		node.Right = build()
+		return node
+	}
+
+	root := build()
+	if !ok || pos != len(tokens) {
+		return nil
+	}
+
+	return &BinaryTree{Root: root}
+}
+
 // Size returns the total number of nodes in the tree
 // Time Complexity: O(n)
 func (bt *BinaryTree) Size() int {
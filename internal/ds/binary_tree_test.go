@@ -292,3 +292,446 @@ func TestBinaryTree_ComplexTree(t *testing.T) {
 		t.Errorf("expected size 5, got %d", bt.Size())
 	}
 }
+
+// assertComplete checks that a level-order walk never finds a node after a gap
+func assertComplete(t *testing.T, bt *BinaryTree) {
+	t.Helper()
+	if bt.Root == nil {
+		return
+	}
+
+	queue := []*TreeNode{bt.Root}
+	seenGap := false
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current == nil {
+			seenGap = true
+			continue
+		}
+		if seenGap {
+			t.Fatalf("tree is not complete: node %d follows a gap", current.Value)
+		}
+		queue = append(queue, current.Left, current.Right)
+	}
+}
+
+func TestBinaryTree_Delete(t *testing.T) {
+	bt := NewBinaryTree()
+	for i := 1; i <= 7; i++ {
+		bt.Insert(i)
+	}
+
+	if !bt.Delete(2) {
+		t.Fatal("delete existing value should succeed")
+	}
+
+	// 7 is the deepest, rightmost node, so it takes 2's place
+	expected := []int{1, 7, 3, 4, 5, 6}
+	if !reflect.DeepEqual(bt.LevelOrderTraversal(), expected) {
+		t.Errorf("expected %v, got %v", expected, bt.LevelOrderTraversal())
+	}
+	if bt.Size() != 6 {
+		t.Errorf("expected size 6, got %d", bt.Size())
+	}
+	assertComplete(t, bt)
+
+	// Deleting the deepest node itself just removes it
+	bt.Delete(6)
+	expected = []int{1, 7, 3, 4, 5}
+	if !reflect.DeepEqual(bt.LevelOrderTraversal(), expected) {
+		t.Errorf("expected %v, got %v", expected, bt.LevelOrderTraversal())
+	}
+	assertComplete(t, bt)
+
+	if bt.Delete(99) {
+		t.Error("delete non-existent value should fail")
+	}
+	if bt.Size() != 5 {
+		t.Errorf("expected size 5, got %d", bt.Size())
+	}
+}
+
+func TestBinaryTree_DeleteRoot(t *testing.T) {
+	bt := NewBinaryTree()
+	for i := 1; i <= 4; i++ {
+		bt.Insert(i)
+	}
+
+	bt.Delete(1)
+	expected := []int{4, 2, 3}
+	if !reflect.DeepEqual(bt.LevelOrderTraversal(), expected) {
+		t.Errorf("expected %v, got %v", expected, bt.LevelOrderTraversal())
+	}
+	assertComplete(t, bt)
+
+	// Drain the tree through the root; inserts must still fill level by level
+	for !bt.IsEmpty() {
+		if !bt.Delete(bt.Root.Value) {
+			t.Fatal("deleting the root value should succeed")
+		}
+		assertComplete(t, bt)
+	}
+	if bt.Delete(1) {
+		t.Error("delete from empty tree should fail")
+	}
+
+	bt.Insert(10)
+	bt.Insert(20)
+	if !reflect.DeepEqual(bt.LevelOrderTraversal(), []int{10, 20}) {
+		t.Errorf("expected [10 20], got %v", bt.LevelOrderTraversal())
+	}
+}
+
+func TestBinaryTree_IsBalanced(t *testing.T) {
+	perfect := NewBinaryTree()
+	for i := 1; i <= 7; i++ {
+		perfect.Insert(i)
+	}
+
+	leftSkewed := NewBinaryTree()
+	leftSkewed.Root = NewTreeNode(1)
+	leftSkewed.Root.Left = NewTreeNode(2)
+	leftSkewed.Root.Left.Left = NewTreeNode(3)
+
+	// Root looks balanced (heights 1 and 0 differ by 1) but node 2 is not
+	deepImbalance := NewBinaryTree()
+	deepImbalance.Root = NewTreeNode(1)
+	deepImbalance.Root.Left = NewTreeNode(2)
+	deepImbalance.Root.Right = NewTreeNode(3)
+	deepImbalance.Root.Left.Left = NewTreeNode(4)
+	deepImbalance.Root.Left.Left.Left = NewTreeNode(5)
+	deepImbalance.Root.Right.Right = NewTreeNode(6)
+
+	tests := []struct {
+		name     string
+		tree     *BinaryTree
+		expected bool
+	}{
+		{"perfect", perfect, true},
+		{"left-skewed", leftSkewed, false},
+		{"imbalance below root", deepImbalance, false},
+		{"empty", NewBinaryTree(), true},
+	}
+
+	for _, tt := range tests {
+		if result := tt.tree.IsBalanced(); result != tt.expected {
+			t.Errorf("IsBalanced(%s): expected %v, got %v", tt.name, tt.expected, result)
+		}
+	}
+}
+
+func TestBinaryTree_Diameter(t *testing.T) {
+	perfect := NewBinaryTree()
+	for i := 1; i <= 7; i++ {
+		perfect.Insert(i)
+	}
+
+	// Longest path 6-4-2-5-7 (4 edges) lives entirely in the left subtree;
+	// the longest path through the root, 1-2-4-6, has only 3
+	//         1
+	//        /
+	//       2
+	//      / \
+	//     4   5
+	//    /     \
+	//   6       7
+	offRoot := NewBinaryTree()
+	offRoot.Root = NewTreeNode(1)
+	offRoot.Root.Left = NewTreeNode(2)
+	offRoot.Root.Left.Left = NewTreeNode(4)
+	offRoot.Root.Left.Right = NewTreeNode(5)
+	offRoot.Root.Left.Left.Left = NewTreeNode(6)
+	offRoot.Root.Left.Right.Right = NewTreeNode(7)
+
+	single := NewBinaryTree()
+	single.Insert(1)
+
+	tests := []struct {
+		name     string
+		tree     *BinaryTree
+		expected int
+	}{
+		{"perfect", perfect, 4},
+		{"off root", offRoot, 4},
+		{"single", single, 0},
+		{"empty", NewBinaryTree(), 0},
+	}
+
+	for _, tt := range tests {
+		if result := tt.tree.Diameter(); result != tt.expected {
+			t.Errorf("Diameter(%s): expected %d, got %d", tt.name, tt.expected, result)
+		}
+	}
+}
+
+func TestBinaryTree_Invert(t *testing.T) {
+	//     4              4
+	//    / \            / \
+	//   2   7    ->    7   2
+	//  / \   \        /   / \
+	// 1   3   9      9   3   1
+	bt := NewBinaryTree()
+	bt.Root = NewTreeNode(4)
+	bt.Root.Left = NewTreeNode(2)
+	bt.Root.Right = NewTreeNode(7)
+	bt.Root.Left.Left = NewTreeNode(1)
+	bt.Root.Left.Right = NewTreeNode(3)
+	bt.Root.Right.Right = NewTreeNode(9)
+
+	bt.Invert()
+
+	expectedLevel := []int{4, 7, 2, 9, 3, 1}
+	if !reflect.DeepEqual(bt.LevelOrderTraversal(), expectedLevel) {
+		t.Errorf("expected level order %v, got %v", expectedLevel, bt.LevelOrderTraversal())
+	}
+	expectedInorder := []int{9, 7, 4, 3, 2, 1}
+	if !reflect.DeepEqual(bt.InorderTraversal(), expectedInorder) {
+		t.Errorf("expected inorder %v, got %v", expectedInorder, bt.InorderTraversal())
+	}
+
+	// Inverting twice restores the original
+	bt.Invert()
+	if !reflect.DeepEqual(bt.InorderTraversal(), []int{1, 2, 3, 4, 7, 9}) {
+		t.Errorf("double invert should restore original, got %v", bt.InorderTraversal())
+	}
+
+	empty := NewBinaryTree()
+	empty.Invert()
+	if !empty.IsEmpty() {
+		t.Error("inverting an empty tree should leave it empty")
+	}
+}
+
+func TestBinaryTree_InvertedCopy(t *testing.T) {
+	bt := NewBinaryTree()
+	bt.Root = NewTreeNode(1)
+	bt.Root.Left = NewTreeNode(2)
+	bt.Root.Left.Right = NewTreeNode(3)
+
+	mirror := bt.InvertedCopy()
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(mirror.LevelOrderTraversal(), expected) {
+		t.Errorf("expected level order %v, got %v", expected, mirror.LevelOrderTraversal())
+	}
+	if mirror.Root.Right == nil || mirror.Root.Right.Left == nil || mirror.Root.Right.Left.Value != 3 {
+		t.Error("expected 2 on the right with 3 as its left child")
+	}
+
+	// Source is untouched and shares no nodes with the copy
+	if bt.Root.Left == nil || bt.Root.Left.Right.Value != 3 || bt.Root.Right != nil {
+		t.Errorf("source tree was mutated: inorder %v", bt.InorderTraversal())
+	}
+	mirror.Root.Value = 100
+	if bt.Root.Value != 1 {
+		t.Error("copy shares nodes with the source")
+	}
+
+	if NewBinaryTree().InvertedCopy().Root != nil {
+		t.Error("copy of empty tree should be empty")
+	}
+}
+
+// assertSameTree compares all four traversals, which together pin down the structure
+func assertSameTree(t *testing.T, expected, actual *BinaryTree) {
+	t.Helper()
+	checks := []struct {
+		name string
+		want []int
+		got  []int
+	}{
+		{"preorder", expected.PreorderTraversal(), actual.PreorderTraversal()},
+		{"inorder", expected.InorderTraversal(), actual.InorderTraversal()},
+		{"postorder", expected.PostorderTraversal(), actual.PostorderTraversal()},
+		{"level order", expected.LevelOrderTraversal(), actual.LevelOrderTraversal()},
+	}
+	for _, c := range checks {
+		if !reflect.DeepEqual(c.want, c.got) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, c.got)
+		}
+	}
+}
+
+func TestBinaryTree_SerializeRoundTrip(t *testing.T) {
+	// Negative values and one-sided children
+	//      -1
+	//     /  \
+	//   -20   3
+	//     \    \
+	//      5   -4
+	sparse := NewBinaryTree()
+	sparse.Root = NewTreeNode(-1)
+	sparse.Root.Left = NewTreeNode(-20)
+	sparse.Root.Right = NewTreeNode(3)
+	sparse.Root.Left.Right = NewTreeNode(5)
+	sparse.Root.Right.Right = NewTreeNode(-4)
+
+	complete := NewBinaryTree()
+	for i := 1; i <= 6; i++ {
+		complete.Insert(i)
+	}
+
+	tests := []struct {
+		name string
+		tree *BinaryTree
+	}{
+		{"sparse", sparse},
+		{"complete", complete},
+		{"empty", NewBinaryTree()},
+	}
+
+	for _, tt := range tests {
+		data := tt.tree.Serialize()
+		restored := Deserialize(data)
+		if restored == nil {
+			t.Fatalf("%s: Deserialize(%q) returned nil", tt.name, data)
+		}
+		assertSameTree(t, tt.tree, restored)
+	}
+
+	expected := "-1,-20,#,5,#,#,3,#,-4,#,#"
+	if data := sparse.Serialize(); data != expected {
+		t.Errorf("expected %q, got %q", expected, data)
+	}
+	if data := NewBinaryTree().Serialize(); data != "#" {
+		t.Errorf("expected empty tree to serialize as %q, got %q", "#", data)
+	}
+}
+
+func TestBinaryTree_DeserializeMalformed(t *testing.T) {
+	for _, data := range []string{"", "1,#", "1,#,#,#", "x,#,#", "1,,#"} {
+		if tree := Deserialize(data); tree != nil {
+			t.Errorf("Deserialize(%q): expected nil, got %v", data, tree.PreorderTraversal())
+		}
+	}
+}
+
+func TestBinaryTree_DeserializeEmptyTree(t *testing.T) {
+	data := NewBinaryTree().Serialize()
+	if data != "#" {
+		t.Fatalf("expected empty tree to serialize as \"#\", got %q", data)
+	}
+
+	restored := Deserialize(data)
+	if restored == nil || !restored.IsEmpty() {
+		t.Error("expected Deserialize(\"#\") to return an empty tree")
+	}
+}
+
+func TestBinaryTree_LevelOrderByLevel(t *testing.T) {
+	complete := NewBinaryTree()
+	for i := 1; i <= 6; i++ {
+		complete.Insert(i)
+	}
+
+	//   1
+	//  /
+	// 2
+	//  \
+	//   3
+	//  / \
+	// 4   5
+	unbalanced := NewBinaryTree()
+	unbalanced.Root = NewTreeNode(1)
+	unbalanced.Root.Left = NewTreeNode(2)
+	unbalanced.Root.Left.Right = NewTreeNode(3)
+	unbalanced.Root.Left.Right.Left = NewTreeNode(4)
+	unbalanced.Root.Left.Right.Right = NewTreeNode(5)
+
+	tests := []struct {
+		name     string
+		tree     *BinaryTree
+		expected [][]int
+	}{
+		{"complete", complete, [][]int{{1}, {2, 3}, {4, 5, 6}}},
+		{"unbalanced", unbalanced, [][]int{{1}, {2}, {3}, {4, 5}}},
+		{"empty", NewBinaryTree(), [][]int{}},
+	}
+
+	for _, tt := range tests {
+		result := tt.tree.LevelOrderByLevel()
+		if !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("LevelOrderByLevel(%s): expected %v, got %v", tt.name, tt.expected, result)
+		}
+		if len(result) != tt.tree.Height()+1 {
+			t.Errorf("LevelOrderByLevel(%s): expected %d levels, got %d", tt.name, tt.tree.Height()+1, len(result))
+		}
+	}
+}
+
+func TestBinaryTree_ZigzagLevelOrder(t *testing.T) {
+	perfect := NewBinaryTree()
+	for i := 1; i <= 15; i++ {
+		perfect.Insert(i)
+	}
+
+	//     3
+	//    / \
+	//   9   20
+	//      /  \
+	//     15   7
+	sparse := NewBinaryTree()
+	sparse.Root = NewTreeNode(3)
+	sparse.Root.Left = NewTreeNode(9)
+	sparse.Root.Right = NewTreeNode(20)
+	sparse.Root.Right.Left = NewTreeNode(15)
+	sparse.Root.Right.Right = NewTreeNode(7)
+
+	single := NewBinaryTree()
+	single.Insert(42)
+
+	tests := []struct {
+		name     string
+		tree     *BinaryTree
+		expected [][]int
+	}{
+		{"perfect", perfect, [][]int{{1}, {3, 2}, {4, 5, 6, 7}, {15, 14, 13, 12, 11, 10, 9, 8}}},
+		{"sparse", sparse, [][]int{{3}, {20, 9}, {15, 7}}},
+		{"single", single, [][]int{{42}}},
+		{"empty", NewBinaryTree(), [][]int{}},
+	}
+
+	for _, tt := range tests {
+		result := tt.tree.ZigzagLevelOrder()
+		if !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("ZigzagLevelOrder(%s): expected %v, got %v", tt.name, tt.expected, result)
+		}
+	}
+}
+
+func TestBinaryTree_SideViews(t *testing.T) {
+	// Below depth 1 only the left subtree continues, so the right view
+	// must pick up 4 and 5 rather than stopping at 3
+	//       1
+	//      / \
+	//     2   3
+	//      \
+	//       4
+	//      /
+	//     5
+	bt := NewBinaryTree()
+	bt.Root = NewTreeNode(1)
+	bt.Root.Left = NewTreeNode(2)
+	bt.Root.Right = NewTreeNode(3)
+	bt.Root.Left.Right = NewTreeNode(4)
+	bt.Root.Left.Right.Left = NewTreeNode(5)
+
+	expectedRight := []int{1, 3, 4, 5}
+	if result := bt.RightSideView(); !reflect.DeepEqual(result, expectedRight) {
+		t.Errorf("RightSideView: expected %v, got %v", expectedRight, result)
+	}
+	expectedLeft := []int{1, 2, 4, 5}
+	if result := bt.LeftSideView(); !reflect.DeepEqual(result, expectedLeft) {
+		t.Errorf("LeftSideView: expected %v, got %v", expectedLeft, result)
+	}
+
+	empty := NewBinaryTree()
+	if result := empty.RightSideView(); len(result) != 0 {
+		t.Errorf("RightSideView on empty tree: expected [], got %v", result)
+	}
+	if result := empty.LeftSideView(); len(result) != 0 {
+		t.Errorf("LeftSideView on empty tree: expected [], got %v", result)
+	}
+}
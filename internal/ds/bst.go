@@ -155,6 +155,52 @@ func (bst *BST) findMax(node *TreeNode) *TreeNode {
 	return current
 }
 
+// Floor returns the largest value less than or equal to target
+// Returns 0 and false if every value is greater than target
+// Time Complexity: O(h) where h is the tree height
+func (bst *BST) Floor(target int) (int, bool) {
+	floor, found := 0, false
+	current := bst.Root
+
+	for current != nil {
+		if current.Value == target {
+			return current.Value, true
+		}
+		if current.Value < target {
+			// Candidate; a closer one can only be in the right subtree
+			floor, found = current.Value, true
+			current = current.Right
+		} else {
+			current = current.Left
+		}
+	}
+
+	return floor, found
+}
+
+// Ceil returns the smallest value greater than or equal to target
+// Returns 0 and false if every value is less than target
+// Time Complexity: O(h) where h is the tree height
+func (bst *BST) Ceil(target int) (int, bool) {
+	ceil, found := 0, false
+	current := bst.Root
+
+	for current != nil {
+		if current.Value == target {
+			return current.Value, true
+		}
+		if current.Value > target {
+			// Candidate; a closer one can only be in the left subtree
+			ceil, found = current.Value, true
+			current = current.Left
+		} else {
+			current = current.Right
+		}
+	}
+
+	return ceil, found
+}
+
 // InorderTraversal returns values in sorted order
 // Time Complexity: O(n)
 func (bst *BST) InorderTraversal() []int {
@@ -173,6 +219,109 @@ func (bst *BST) inorderHelper(node *TreeNode, result *[]int) {
 	bst.inorderHelper(node.Right, result)
 }
 
+// KthSmallest returns the kth smallest value (1-indexed)
+// Uses an iterative inorder walk that stops as soon as the kth node is visited
+// Returns 0 and false if k < 1 or k > Size()
+// Time Complexity: O(h + k)
+// Space Complexity: O(h) for the explicit stack
+func (bst *BST) KthSmallest(k int) (int, bool) {
+	return bst.kthInorder(k, false)
+}
+
+// KthLargest returns the kth largest value (1-indexed)
+// Walks the tree in reverse inorder (right, node, left) and stops early
+// Returns 0 and false if k < 1 or k > Size()
+// Time Complexity: O(h + k)
+// Space Complexity: O(h) for the explicit stack
+func (bst *BST) KthLargest(k int) (int, bool) {
+	return bst.kthInorder(k, true)
+}
+
+func (bst *BST) kthInorder(k int, reverse bool) (int, bool) {
+	if k < 1 {
+		return 0, false
+	}
+
+	// first is the side visited before the node, second the side after
+	first := func(n *TreeNode) *TreeNode { return n.Left }
+	second := func(n *TreeNode) *TreeNode { return n.Right }
+	if reverse {
+		first, second = second, first
+	}
+
+	stack := []*TreeNode{}
+	current := bst.Root
+
+	for current != nil || len(stack) > 0 {
+		for current != nil {
+			stack = append(stack, current)
+			current = first(current)
+		}
+
+		current = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		k--
+		if k == 0 {
+			return current.Value, true
+		}
+
+		current = second(current)
+	}
+
+	return 0, false // Fewer than k nodes
+}
+
+// RangeQuery returns all values in [low, high] in ascending order
+// Subtrees that cannot hold in-range values are skipped entirely
+// Time Complexity: O(h + m) where m is the number of values returned
+func (bst *BST) RangeQuery(low, high int) []int {
+	result := []int{}
+	if low <= high {
+		bst.rangeHelper(bst.Root, low, high, &result)
+	}
+	return result
+}
+
+func (bst *BST) rangeHelper(node *TreeNode, low, high int, result *[]int) {
+	if node == nil {
+		return
+	}
+
+	// Everything on the left is smaller than node.Value, so only look when it can reach low
+	if node.Value > low {
+		bst.rangeHelper(node.Left, low, high, result)
+	}
+	if node.Value >= low && node.Value <= high {
+		*result = append(*result, node.Value)
+	}
+	if node.Value < high {
+		bst.rangeHelper(node.Right, low, high, result)
+	}
+}
+
+// ToBalanced rebuilds the tree so its height is floor(log2(n))
+// Takes the sorted inorder values and recursively makes the middle value each subtree's root
+// Useful when a tree is built once (possibly from sorted input) and then only queried
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+func (bst *BST) ToBalanced() {
+	values := bst.InorderTraversal()
+	bst.Root = buildBalanced(values)
+}
+
+func buildBalanced(values []int) *TreeNode {
+	if len(values) == 0 {
+		return nil
+	}
+
+	mid := len(values) / 2
+	node := NewTreeNode(values[mid])
+	node.Left = buildBalanced(values[:mid])
+	node.Right = buildBalanced(values[mid+1:])
+	return node
+}
+
 // Height returns the height of the BST
 // Time Complexity: O(n)
 func (bst *BST) Height() int {
@@ -1,6 +1,7 @@
 package ds
 
 import (
+	"math/rand"
 	"reflect"
 	"testing"
 )
@@ -342,3 +343,204 @@ func TestBST_ComplexOperations(t *testing.T) {
 		}
 	}
 }
+
+func TestBST_FloorAndCeil(t *testing.T) {
+	bst := NewBST()
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+		bst.Insert(v)
+	}
+
+	tests := []struct {
+		target  int
+		floor   int
+		floorOK bool
+		ceil    int
+		ceilOK  bool
+	}{
+		{40, 40, true, 40, true}, // Exact match
+		{45, 40, true, 50, true}, // Between a node and its ancestor
+		{55, 50, true, 60, true}, // Between root and right subtree
+		{65, 60, true, 70, true},
+		{10, 0, false, 20, true}, // Smaller than all values
+		{90, 80, true, 0, false}, // Larger than all values
+		{20, 20, true, 20, true}, // Minimum itself
+		{80, 80, true, 80, true}, // Maximum itself
+	}
+
+	for _, tt := range tests {
+		floor, ok := bst.Floor(tt.target)
+		if floor != tt.floor || ok != tt.floorOK {
+			t.Errorf("Floor(%d): expected (%d, %v), got (%d, %v)", tt.target, tt.floor, tt.floorOK, floor, ok)
+		}
+		ceil, ok := bst.Ceil(tt.target)
+		if ceil != tt.ceil || ok != tt.ceilOK {
+			t.Errorf("Ceil(%d): expected (%d, %v), got (%d, %v)", tt.target, tt.ceil, tt.ceilOK, ceil, ok)
+		}
+	}
+}
+
+func TestBST_FloorAndCeilEmpty(t *testing.T) {
+	bst := NewBST()
+
+	if _, ok := bst.Floor(5); ok {
+		t.Error("floor on empty BST should fail")
+	}
+	if _, ok := bst.Ceil(5); ok {
+		t.Error("ceil on empty BST should fail")
+	}
+}
+
+func TestBST_KthSmallestAndLargest(t *testing.T) {
+	bst := NewBST()
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+		bst.Insert(v)
+	}
+	size := bst.Size()
+
+	if val, ok := bst.KthSmallest(1); !ok || val != 20 {
+		t.Errorf("KthSmallest(1): expected min 20, got %d", val)
+	}
+	if val, ok := bst.KthSmallest(size); !ok || val != 80 {
+		t.Errorf("KthSmallest(%d): expected max 80, got %d", size, val)
+	}
+	if val, ok := bst.KthLargest(1); !ok || val != 80 {
+		t.Errorf("KthLargest(1): expected max 80, got %d", val)
+	}
+	if val, ok := bst.KthLargest(size); !ok || val != 20 {
+		t.Errorf("KthLargest(%d): expected min 20, got %d", size, val)
+	}
+	if val, ok := bst.KthLargest(3); !ok || val != 60 {
+		t.Errorf("KthLargest(3): expected 60, got %d", val)
+	}
+
+	for _, k := range []int{0, -1, size + 1} {
+		if _, ok := bst.KthSmallest(k); ok {
+			t.Errorf("KthSmallest(%d): expected out of range", k)
+		}
+		if _, ok := bst.KthLargest(k); ok {
+			t.Errorf("KthLargest(%d): expected out of range", k)
+		}
+	}
+
+	if _, ok := NewBST().KthSmallest(1); ok {
+		t.Error("KthSmallest on empty BST should fail")
+	}
+}
+
+func TestBST_KthMatchesInorder(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+
+	for trial := 0; trial < 20; trial++ {
+		bst := NewBST()
+		for i := 0; i < 1+rng.Intn(50); i++ {
+			bst.Insert(rng.Intn(200))
+		}
+
+		inorder := bst.InorderTraversal()
+		n := len(inorder)
+		for k := 1; k <= n; k++ {
+			if val, ok := bst.KthSmallest(k); !ok || val != inorder[k-1] {
+				t.Fatalf("KthSmallest(%d): expected %d, got %d (tree %v)", k, inorder[k-1], val, inorder)
+			}
+			if val, ok := bst.KthLargest(k); !ok || val != inorder[n-k] {
+				t.Fatalf("KthLargest(%d): expected %d, got %d (tree %v)", k, inorder[n-k], val, inorder)
+			}
+		}
+	}
+}
+
+func TestBST_RangeQuery(t *testing.T) {
+	bst := NewBST()
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80, 35, 65} {
+		bst.Insert(v)
+	}
+
+	tests := []struct {
+		low, high int
+		expected  []int
+	}{
+		{0, 100, []int{20, 30, 35, 40, 50, 60, 65, 70, 80}}, // Whole tree
+		{41, 49, []int{}},                   // Falls between nodes
+		{90, 100, []int{}},                  // Beyond max
+		{30, 60, []int{30, 35, 40, 50, 60}}, // Boundaries are stored values
+		{65, 65, []int{65}},
+		{60, 30, []int{}}, // low > high
+	}
+
+	for _, tt := range tests {
+		result := bst.RangeQuery(tt.low, tt.high)
+		if !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("RangeQuery(%d, %d): expected %v, got %v", tt.low, tt.high, tt.expected, result)
+		}
+		for i := 1; i < len(result); i++ {
+			if result[i] <= result[i-1] {
+				t.Errorf("RangeQuery(%d, %d): result %v not sorted ascending", tt.low, tt.high, result)
+			}
+		}
+	}
+}
+
+func TestBST_RangeQueryPrunes(t *testing.T) {
+	bst := NewBST()
+	for _, v := range []int{50, 30, 70, 20, 80} {
+		bst.Insert(v)
+	}
+
+	// Plant a sentinel in each subtree outside the range; visiting it would leak it into the result
+	bst.Root.Left.Left.Left = NewTreeNode(55)
+	bst.Root.Right.Right.Right = NewTreeNode(45)
+
+	expected := []int{30, 50, 70}
+	if result := bst.RangeQuery(30, 70); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v (out-of-range subtree was visited)", expected, result)
+	}
+}
+
+func TestBST_ToBalanced(t *testing.T) {
+	bst := NewBST()
+	n := 127
+	for i := 1; i <= n; i++ {
+		bst.Insert(i)
+	}
+
+	if bst.Height() != n-1 {
+		t.Fatalf("expected degenerate height %d, got %d", n-1, bst.Height())
+	}
+	before := bst.InorderTraversal()
+
+	bst.ToBalanced()
+
+	if bst.Height() != 6 { // floor(log2(127))
+		t.Errorf("expected height 6 after balancing, got %d", bst.Height())
+	}
+	if !reflect.DeepEqual(bst.InorderTraversal(), before) {
+		t.Error("inorder traversal should be unchanged after balancing")
+	}
+	if !bst.IsValidBST() {
+		t.Error("BST property should hold after balancing")
+	}
+
+	// Still a working BST
+	bst.Insert(200)
+	bst.Delete(64)
+	if !bst.Search(200) || bst.Search(64) || !bst.IsValidBST() {
+		t.Error("balanced tree should support further inserts and deletes")
+	}
+}
+
+func TestBST_ToBalancedSmallTrees(t *testing.T) {
+	bst := NewBST()
+	bst.ToBalanced()
+	if !bst.IsEmpty() {
+		t.Error("balancing an empty tree should leave it empty")
+	}
+
+	for _, v := range []int{3, 2, 1, 0} {
+		bst.Insert(v)
+	}
+	bst.ToBalanced()
+
+	if bst.Height() != 2 || bst.Size() != 4 {
+		t.Errorf("expected height 2 and size 4, got height %d size %d", bst.Height(), bst.Size())
+	}
+}
@@ -0,0 +1,128 @@
+package ds
+
+// Why interviewers ask this:
+// A deque generalizes both stack and queue, and it is the key structure behind the
+// monotonic-queue trick (sliding window maximum in O(n)). Interviewers use it to check
+// that you can do O(1) work at both ends without shifting a slice.
+
+// Common pitfalls:
+// - Prepending to a slice (O(n) per PushFront)
+// - Negative modulo when moving front backwards: (front-1)%n is -1 in Go, use (front-1+n)%n
+// - Forgetting to re-linearize elements when growing a wrapped ring buffer
+// - Leaving popped values in the buffer so the GC can't reclaim them
+
+// Key takeaway:
+// Use a ring buffer with a front index and a size. PushFront steps front back one slot,
+// PushBack writes at (front+size)%cap, and both pops just move an index. Double the
+// buffer (copying in logical order) when full for O(1) amortized pushes.
+
+// Deque is a double-ended queue backed by a growable ring buffer
+// Time Complexity: Push/Pop/Peek at either end O(1) amortized
+// Space Complexity: O(n) where n is the number of elements
+type Deque struct {
+	items []interface{}
+	front int // Index of the first element
+	size  int
+}
+
+// NewDeque creates and returns a new empty deque
+func NewDeque() *Deque {
+	return &Deque{
+		items: make([]interface{}, 4),
+	}
+}
+
+// PushFront adds an element at the front
+// Time Complexity: O(1) amortized
+func (d *Deque) PushFront(item interface{}) {
+	d.growIfFull()
+	d.front = (d.front - 1 + len(d.items)) % len(d.items)
+	d.items[d.front] = item
+	d.size++
+}
+
+// PushBack adds an element at the back
+// Time Complexity: O(1) amortized
+func (d *Deque) PushBack(item interface{}) {
+	d.growIfFull()
+	d.items[(d.front+d.size)%len(d.items)] = item
+	d.size++
+}
+
+// PopFront removes and returns the front element
+// Returns nil and false if deque is empty
+// Time Complexity: O(1)
+func (d *Deque) PopFront() (interface{}, bool) {
+	if d.IsEmpty() {
+		return nil, false
+	}
+
+	item := d.items[d.front]
+	d.items[d.front] = nil
+	d.front = (d.front + 1) % len(d.items)
+	d.size--
+
+	return item, true
+}
+
+// PopBack removes and returns the back element
+// Returns nil and false if deque is empty
+// Time Complexity: O(1)
+func (d *Deque) PopBack() (interface{}, bool) {
+	if d.IsEmpty() {
+		return nil, false
+	}
+
+	back := (d.front + d.size - 1) % len(d.items)
+	item := d.items[back]
+	d.items[back] = nil
+	d.size--
+
+	return item, true
+}
+
+// PeekFront returns the front element without removing it
+// Returns nil and false if deque is empty
+// Time Complexity: O(1)
+func (d *Deque) PeekFront() (interface{}, bool) {
+	if d.IsEmpty() {
+		return nil, false
+	}
+
+	return d.items[d.front], true
+}
+
+// PeekBack returns the back element without removing it
+// Returns nil and false if deque is empty
+// Time Complexity: O(1)
+func (d *Deque) PeekBack() (interface{}, bool) {
+	if d.IsEmpty() {
+		return nil, false
+	}
+
+	return d.items[(d.front+d.size-1)%len(d.items)], true
+}
+
+// IsEmpty returns true if the deque has no elements
+func (d *Deque) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Size returns the number of elements
+func (d *Deque) Size() int {
+	return d.size
+}
+
+// growIfFull doubles the buffer, unwrapping the elements so front is index 0
+func (d *Deque) growIfFull() {
+	if d.size < len(d.items) {
+		return
+	}
+
+	grown := make([]interface{}, len(d.items)*2)
+	for i := 0; i < d.size; i++ {
+		grown[i] = d.items[(d.front+i)%len(d.items)]
+	}
+	d.items = grown
+	d.front = 0
+}
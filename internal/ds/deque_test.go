@@ -0,0 +1,130 @@
+package ds
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeque_AsQueue(t *testing.T) {
+	d := NewDeque()
+	for i := 1; i <= 10; i++ { // Past the initial buffer size
+		d.PushBack(i)
+	}
+
+	var result []interface{}
+	for !d.IsEmpty() {
+		v, _ := d.PopFront()
+		result = append(result, v)
+	}
+
+	expected := []interface{}{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected FIFO %v, got %v", expected, result)
+	}
+}
+
+func TestDeque_AsStack(t *testing.T) {
+	d := NewDeque()
+	for i := 1; i <= 10; i++ {
+		d.PushFront(i)
+	}
+
+	var result []interface{}
+	for !d.IsEmpty() {
+		v, _ := d.PopFront()
+		result = append(result, v)
+	}
+
+	expected := []interface{}{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected LIFO %v, got %v", expected, result)
+	}
+}
+
+func TestDeque_InterleavedEnds(t *testing.T) {
+	d := NewDeque()
+
+	d.PushBack(2)
+	d.PushFront(1)
+	d.PushBack(3)
+	d.PushFront(0) // 0 1 2 3, with front wrapped around the buffer
+
+	if v, _ := d.PeekFront(); v != 0 {
+		t.Errorf("expected front 0, got %v", v)
+	}
+	if v, _ := d.PeekBack(); v != 3 {
+		t.Errorf("expected back 3, got %v", v)
+	}
+
+	d.PushBack(4) // Grows while wrapped
+	d.PushFront(-1)
+
+	if v, _ := d.PopBack(); v != 4 {
+		t.Errorf("expected pop back 4, got %v", v)
+	}
+	if v, _ := d.PopFront(); v != -1 {
+		t.Errorf("expected pop front -1, got %v", v)
+	}
+
+	var result []interface{}
+	for !d.IsEmpty() {
+		v, _ := d.PopBack()
+		result = append(result, v)
+	}
+
+	expected := []interface{}{3, 2, 1, 0}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestDeque_Empty(t *testing.T) {
+	d := NewDeque()
+
+	if _, ok := d.PopFront(); ok {
+		t.Error("pop front from empty deque should fail")
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Error("pop back from empty deque should fail")
+	}
+	if _, ok := d.PeekFront(); ok {
+		t.Error("peek front on empty deque should fail")
+	}
+	if _, ok := d.PeekBack(); ok {
+		t.Error("peek back on empty deque should fail")
+	}
+
+	d.PushBack("x")
+	d.PopFront()
+	if !d.IsEmpty() || d.Size() != 0 {
+		t.Error("deque should be empty again")
+	}
+}
+
+func TestDeque_SlidingWindowMax(t *testing.T) {
+	// Monotonic deque of indices: values decrease from front to back
+	nums := []int{1, 3, -1, -3, 5, 3, 6, 7}
+	k := 3
+
+	d := NewDeque()
+	var maxes []int
+	for i, n := range nums {
+		if front, ok := d.PeekFront(); ok && front.(int) <= i-k {
+			d.PopFront() // Slid out of the window
+		}
+		for back, ok := d.PeekBack(); ok && nums[back.(int)] <= n; back, ok = d.PeekBack() {
+			d.PopBack()
+		}
+		d.PushBack(i)
+
+		if i >= k-1 {
+			front, _ := d.PeekFront()
+			maxes = append(maxes, nums[front.(int)])
+		}
+	}
+
+	expected := []int{3, 3, 5, 5, 6, 7}
+	if !reflect.DeepEqual(maxes, expected) {
+		t.Errorf("expected %v, got %v", expected, maxes)
+	}
+}
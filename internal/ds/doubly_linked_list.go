@@ -0,0 +1,196 @@
+package ds
+
+// Why interviewers ask this:
+// Doubly linked lists power LRU caches, browser history, undo stacks and Go's own
+// container/list. The extra Prev pointer turns tail deletion and "remove this node" into
+// O(1) operations, and interviewers want to see you keep both directions consistent.
+
+// Common pitfalls:
+// - Updating Next but forgetting the matching Prev (forward and backward walks disagree)
+// - Not clearing tail when the last node is removed (or head when the list empties)
+// - Reverse swapping only Next pointers, leaving Prev pointing the old way
+// - Traversing from the head to delete the tail, which throws away the whole benefit
+
+// Key takeaway:
+// Every link change happens in pairs: a.Next = b and b.Prev = a. With head and tail
+// pointers, insert and delete at either end are O(1), and Get can walk from whichever
+// end is closer. Reversing is just swapping Next/Prev on every node plus head/tail.
+
+// DoublyNode represents a single node in a doubly linked list
+type DoublyNode struct {
+	Value interface{}
+	Prev  *DoublyNode
+	Next  *DoublyNode
+}
+
+// DoublyLinkedList represents a doubly linked list
+// Time Complexity: Insert/Delete O(1) at head and tail, O(n) by value
+//
+//	Get O(n/2) - walks from the closer end
+//
+// Space Complexity: O(n) where n is the number of nodes
+type DoublyLinkedList struct {
+	head *DoublyNode
+	tail *DoublyNode
+	size int
+}
+
+// NewDoublyLinkedList creates and returns a new empty doubly linked list
+func NewDoublyLinkedList() *DoublyLinkedList {
+	return &DoublyLinkedList{}
+}
+
+// InsertAtHead adds a new node at the beginning of the list
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList) InsertAtHead(value interface{}) {
+	newNode := &DoublyNode{Value: value, Next: dl.head}
+
+	if dl.head == nil {
+		dl.tail = newNode
+	} else {
+		dl.head.Prev = newNode
+	}
+	dl.head = newNode
+
+	dl.size++
+}
+
+// InsertAtTail adds a new node at the end of the list
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList) InsertAtTail(value interface{}) {
+	newNode := &DoublyNode{Value: value, Prev: dl.tail}
+
+	if dl.tail == nil {
+		dl.head = newNode
+	} else {
+		dl.tail.Next = newNode
+	}
+	dl.tail = newNode
+
+	dl.size++
+}
+
+// DeleteAtHead removes the first node
+// Returns the value and true if successful, nil and false if list is empty
+// Time Complexity: O(1)
+func (dl *DoublyLinkedList) DeleteAtHead() (interface{}, bool) {
+	if dl.head == nil {
+		return nil, false
+	}
+
+	node := dl.head
+	dl.unlink(node)
+	return node.Value, true
+}
+
+// DeleteAtTail removes the last node
+// Returns the value and true if successful, nil and false if list is empty
+// Time Complexity: O(1) - the Prev pointer gives the new tail directly
+func (dl *DoublyLinkedList) DeleteAtTail() (interface{}, bool) {
+	if dl.tail == nil {
+		return nil, false
+	}
+
+	node := dl.tail
+	dl.unlink(node)
+	return node.Value, true
+}
+
+// DeleteValue removes the first occurrence of the value
+// Returns true if value was found and deleted
+// Time Complexity: O(n) to find, O(1) to unlink
+func (dl *DoublyLinkedList) DeleteValue(value interface{}) bool {
+	for current := dl.head; current != nil; current = current.Next {
+		if current.Value == value {
+			dl.unlink(current)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Get returns the value at the specified position
+// Returns nil and false if position is invalid
+// Time Complexity: O(n/2) - walks from whichever end is closer
+func (dl *DoublyLinkedList) Get(position int) (interface{}, bool) {
+	if position < 0 || position >= dl.size {
+		return nil, false
+	}
+
+	if position < dl.size/2 {
+		current := dl.head
+		for i := 0; i < position; i++ {
+			current = current.Next
+		}
+		return current.Value, true
+	}
+
+	current := dl.tail
+	for i := dl.size - 1; i > position; i-- {
+		current = current.Prev
+	}
+	return current.Value, true
+}
+
+// Reverse reverses the list in place by swapping every node's Prev and Next
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func (dl *DoublyLinkedList) Reverse() {
+	for current := dl.head; current != nil; current = current.Prev {
+		// After the swap, the old Next is in Prev
+		current.Next, current.Prev = current.Prev, current.Next
+	}
+
+	dl.head, dl.tail = dl.tail, dl.head
+}
+
+// ToSlice converts the list to a slice, head to tail
+// Time Complexity: O(n)
+func (dl *DoublyLinkedList) ToSlice() []interface{} {
+	result := make([]interface{}, 0, dl.size)
+	for current := dl.head; current != nil; current = current.Next {
+		result = append(result, current.Value)
+	}
+	return result
+}
+
+// ToSliceReverse converts the list to a slice by walking Prev pointers from tail to head
+// Time Complexity: O(n)
+func (dl *DoublyLinkedList) ToSliceReverse() []interface{} {
+	result := make([]interface{}, 0, dl.size)
+	for current := dl.tail; current != nil; current = current.Prev {
+		result = append(result, current.Value)
+	}
+	return result
+}
+
+// IsEmpty returns true if the list has no nodes
+func (dl *DoublyLinkedList) IsEmpty() bool {
+	return dl.head == nil
+}
+
+// Size returns the number of nodes in the list
+func (dl *DoublyLinkedList) Size() int {
+	return dl.size
+}
+
+// unlink removes node from the list, fixing neighbors and head/tail
+func (dl *DoublyLinkedList) unlink(node *DoublyNode) {
+	if node.Prev == nil {
+		dl.head = node.Next
+	} else {
+		node.Prev.Next = node.Next
+	}
+
+	if node.Next == nil {
+		dl.tail = node.Prev
+	} else {
+		node.Next.Prev = node.Prev
+	}
+
+	// Detach so the removed node doesn't keep neighbors reachable
+	node.Prev = nil
+	node.Next = nil
+	dl.size--
+}
@@ -0,0 +1,219 @@
+package ds
+
+import (
+	"reflect"
+	"testing"
+)
+
+// reversed returns a reversed copy of values
+func reversed(values []interface{}) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		result[len(values)-1-i] = v
+	}
+	return result
+}
+
+// assertMirrored checks that backward traversal is exactly forward traversal reversed
+func assertMirrored(t *testing.T, dl *DoublyLinkedList) {
+	t.Helper()
+	if !reflect.DeepEqual(dl.ToSliceReverse(), reversed(dl.ToSlice())) {
+		t.Errorf("forward %v and reverse %v are not mirrors", dl.ToSlice(), dl.ToSliceReverse())
+	}
+}
+
+func TestDoublyLinkedList_InsertAtHead(t *testing.T) {
+	dl := NewDoublyLinkedList()
+
+	dl.InsertAtHead(3)
+	dl.InsertAtHead(2)
+	dl.InsertAtHead(1)
+
+	if dl.Size() != 3 {
+		t.Errorf("expected size 3, got %d", dl.Size())
+	}
+
+	expected := []interface{}{1, 2, 3}
+	if !reflect.DeepEqual(dl.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, dl.ToSlice())
+	}
+	assertMirrored(t, dl)
+}
+
+func TestDoublyLinkedList_InsertAtTail(t *testing.T) {
+	dl := NewDoublyLinkedList()
+
+	dl.InsertAtTail(1)
+	dl.InsertAtTail(2)
+	dl.InsertAtTail(3)
+
+	expected := []interface{}{1, 2, 3}
+	if !reflect.DeepEqual(dl.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, dl.ToSlice())
+	}
+
+	expectedReverse := []interface{}{3, 2, 1}
+	if !reflect.DeepEqual(dl.ToSliceReverse(), expectedReverse) {
+		t.Errorf("expected %v, got %v", expectedReverse, dl.ToSliceReverse())
+	}
+}
+
+func TestDoublyLinkedList_DeleteAtHead(t *testing.T) {
+	dl := NewDoublyLinkedList()
+	dl.InsertAtTail(1)
+	dl.InsertAtTail(2)
+
+	val, ok := dl.DeleteAtHead()
+	if !ok || val != 1 {
+		t.Errorf("expected 1, got %v", val)
+	}
+	assertMirrored(t, dl)
+
+	dl.DeleteAtHead()
+	if !dl.IsEmpty() || dl.tail != nil {
+		t.Error("list should be empty with nil tail")
+	}
+
+	if _, ok := dl.DeleteAtHead(); ok {
+		t.Error("delete from empty list should fail")
+	}
+}
+
+func TestDoublyLinkedList_DeleteAtTail(t *testing.T) {
+	dl := NewDoublyLinkedList()
+	dl.InsertAtTail(1)
+	dl.InsertAtTail(2)
+	dl.InsertAtTail(3)
+
+	val, ok := dl.DeleteAtTail()
+	if !ok || val != 3 {
+		t.Errorf("expected 3, got %v", val)
+	}
+
+	expected := []interface{}{1, 2}
+	if !reflect.DeepEqual(dl.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, dl.ToSlice())
+	}
+	assertMirrored(t, dl)
+
+	dl.DeleteAtTail()
+	dl.DeleteAtTail()
+	if !dl.IsEmpty() || dl.head != nil || dl.Size() != 0 {
+		t.Error("list should be empty with nil head")
+	}
+
+	if _, ok := dl.DeleteAtTail(); ok {
+		t.Error("delete from empty list should fail")
+	}
+}
+
+func TestDoublyLinkedList_DeleteAtTailDoesNotTraverse(t *testing.T) {
+	dl := NewDoublyLinkedList()
+	dl.InsertAtTail(1)
+	dl.InsertAtTail(2)
+	dl.InsertAtTail(3)
+
+	// Sever the forward chain from the head: any walk from head would never reach the tail
+	secondNode := dl.head.Next
+	dl.head.Next = nil
+
+	val, ok := dl.DeleteAtTail()
+	if !ok || val != 3 {
+		t.Errorf("expected 3, got %v", val)
+	}
+	if dl.tail != secondNode || secondNode.Next != nil {
+		t.Error("expected tail to move to its Prev without touching the head")
+	}
+}
+
+func TestDoublyLinkedList_DeleteValue(t *testing.T) {
+	dl := NewDoublyLinkedList()
+	for _, v := range []int{1, 2, 3, 2, 4} {
+		dl.InsertAtTail(v)
+	}
+
+	// Middle, first occurrence only
+	if !dl.DeleteValue(2) {
+		t.Error("delete existing value should succeed")
+	}
+	expected := []interface{}{1, 3, 2, 4}
+	if !reflect.DeepEqual(dl.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, dl.ToSlice())
+	}
+
+	// Head and tail
+	dl.DeleteValue(1)
+	dl.DeleteValue(4)
+	expected = []interface{}{3, 2}
+	if !reflect.DeepEqual(dl.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, dl.ToSlice())
+	}
+	assertMirrored(t, dl)
+
+	if dl.DeleteValue(99) {
+		t.Error("delete non-existent value should fail")
+	}
+	if dl.Size() != 2 {
+		t.Errorf("expected size 2, got %d", dl.Size())
+	}
+}
+
+func TestDoublyLinkedList_Get(t *testing.T) {
+	dl := NewDoublyLinkedList()
+	for i := 0; i < 7; i++ {
+		dl.InsertAtTail(i * 10)
+	}
+
+	// Covers both the head-side and tail-side walks
+	for i := 0; i < 7; i++ {
+		val, ok := dl.Get(i)
+		if !ok || val != i*10 {
+			t.Errorf("Get(%d): expected %d, got %v", i, i*10, val)
+		}
+	}
+
+	if _, ok := dl.Get(-1); ok {
+		t.Error("get at negative position should fail")
+	}
+	if _, ok := dl.Get(7); ok {
+		t.Error("get at out of bounds position should fail")
+	}
+}
+
+func TestDoublyLinkedList_Reverse(t *testing.T) {
+	dl := NewDoublyLinkedList()
+	for _, v := range []int{1, 2, 3, 4} {
+		dl.InsertAtTail(v)
+	}
+
+	dl.Reverse()
+
+	expected := []interface{}{4, 3, 2, 1}
+	if !reflect.DeepEqual(dl.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, dl.ToSlice())
+	}
+	assertMirrored(t, dl)
+
+	// Head and tail must be usable after reversal
+	dl.InsertAtTail(0)
+	dl.InsertAtHead(5)
+	expected = []interface{}{5, 4, 3, 2, 1, 0}
+	if !reflect.DeepEqual(dl.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, dl.ToSlice())
+	}
+	assertMirrored(t, dl)
+}
+
+func TestDoublyLinkedList_ReverseEmptyAndSingle(t *testing.T) {
+	dl := NewDoublyLinkedList()
+	dl.Reverse()
+	if !dl.IsEmpty() {
+		t.Error("reversed empty list should be empty")
+	}
+
+	dl.InsertAtTail(1)
+	dl.Reverse()
+	if val, _ := dl.Get(0); val != 1 || dl.head != dl.tail {
+		t.Errorf("expected single node 1, got %v", dl.ToSlice())
+	}
+}
@@ -0,0 +1,136 @@
+package ds
+
+// Why interviewers ask this:
+// Real priority queues hold tasks, events or graph edges, not bare ints. Writing one heap
+// that takes a comparator shows you understand that min vs max is just the direction of
+// one comparison, and that the sift logic never needs to know what T is.
+
+// Common pitfalls:
+// - Duplicating the whole heap for min and max instead of flipping the comparator
+// - Using a non-strict comparator (<=) so equal elements are swapped needlessly
+// - Leaving the extracted element referenced in the backing array (keeps pointers alive)
+// - Sorting the input for BuildHeap (O(n log n)) when sift-down from the middle is O(n)
+
+// Key takeaway:
+// A heap only ever asks "should a come before b?". Pass that question in as less(a, b)
+// and the same array-backed sift-up/sift-down code gives you a min-heap, a max-heap,
+// or a heap of structs ordered by any field.
+
+// Heap is a binary heap ordered by a caller-supplied comparator
+// The element for which less reports true against all others sits at the root
+// Time Complexity: Insert O(log n), Extract O(log n), Peek O(1)
+// Space Complexity: O(n) where n is the number of elements
+type Heap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// NewHeap creates an empty heap ordered by less
+// Use func(a, b int) bool { return a < b } for a min-heap and > for a max-heap
+func NewHeap[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{
+		items: make([]T, 0),
+		less:  less,
+	}
+}
+
+// Insert adds a value to the heap
+// Time Complexity: O(log n)
+func (h *Heap[T]) Insert(value T) {
+	h.items = append(h.items, value)
+	h.heapifyUp(len(h.items) - 1)
+}
+
+// Extract removes and returns the root element
+// Returns the zero value and false if heap is empty
+// Time Complexity: O(log n)
+func (h *Heap[T]) Extract() (T, bool) {
+	var zero T
+	if h.IsEmpty() {
+		return zero, false
+	}
+
+	top := h.items[0]
+	lastIdx := len(h.items) - 1
+
+	h.items[0] = h.items[lastIdx]
+	h.items[lastIdx] = zero // Don't keep the moved element reachable from the spare capacity
+	h.items = h.items[:lastIdx]
+
+	if len(h.items) > 0 {
+		h.heapifyDown(0)
+	}
+
+	return top, true
+}
+
+// Peek returns the root element without removing it
+// Time Complexity: O(1)
+func (h *Heap[T]) Peek() (T, bool) {
+	if h.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+
+	return h.items[0], true
+}
+
+// BuildHeap replaces the contents with values
+// Time Complexity: O(n)
+func (h *Heap[T]) BuildHeap(values []T) {
+	h.items = make([]T, len(values))
+	copy(h.items, values)
+
+	// Start from last non-leaf node and heapify down
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.heapifyDown(i)
+	}
+}
+
+func (h *Heap[T]) heapifyUp(index int) {
+	for index > 0 {
+		parentIdx := (index - 1) / 2
+
+		if !h.less(h.items[index], h.items[parentIdx]) {
+			break
+		}
+
+		h.items[index], h.items[parentIdx] = h.items[parentIdx], h.items[index]
+		index = parentIdx
+	}
+}
+
+func (h *Heap[T]) heapifyDown(index int) {
+	size := len(h.items)
+
+	for {
+		first := index
+		leftChild := 2*index + 1
+		rightChild := 2*index + 2
+
+		if leftChild < size && h.less(h.items[leftChild], h.items[first]) {
+			first = leftChild
+		}
+
+		if rightChild < size && h.less(h.items[rightChild], h.items[first]) {
+			first = rightChild
+		}
+
+		if first == index {
+			break
+		}
+
+		h.items[index], h.items[first] = h.items[first], h.items[index]
+		index = first
+	}
+}
+
+// IsEmpty returns true if heap has no elements
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+// Size returns the number of elements in the heap
+func (h *Heap[T]) Size() int {
+	return len(h.items)
+}
@@ -0,0 +1,90 @@
+package ds
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHeap_MinInts(t *testing.T) {
+	h := NewHeap(func(a, b int) bool { return a < b })
+
+	for _, v := range []int{5, 3, 8, 1, 9, 2} {
+		h.Insert(v)
+	}
+
+	if top, ok := h.Peek(); !ok || top != 1 {
+		t.Errorf("expected peek 1, got %d", top)
+	}
+
+	var result []int
+	for !h.IsEmpty() {
+		val, _ := h.Extract()
+		result = append(result, val)
+	}
+
+	expected := []int{1, 2, 3, 5, 8, 9}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestHeap_MaxIntsWithBuildHeap(t *testing.T) {
+	h := NewHeap(func(a, b int) bool { return a > b })
+
+	values := []int{9, 5, 6, 2, 3, 7, 1, 4, 8}
+	h.BuildHeap(values)
+
+	if h.Size() != len(values) {
+		t.Errorf("expected size %d, got %d", len(values), h.Size())
+	}
+
+	var result []int
+	for !h.IsEmpty() {
+		val, _ := h.Extract()
+		result = append(result, val)
+	}
+
+	expected := []int{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestHeap_Structs(t *testing.T) {
+	type job struct {
+		name     string
+		priority int
+	}
+
+	// Higher priority first
+	h := NewHeap(func(a, b job) bool { return a.priority > b.priority })
+	h.Insert(job{"email", 2})
+	h.Insert(job{"page", 9})
+	h.Insert(job{"report", 1})
+	h.Insert(job{"deploy", 5})
+
+	var order []string
+	for !h.IsEmpty() {
+		j, _ := h.Extract()
+		order = append(order, j.name)
+	}
+
+	expected := []string{"page", "deploy", "email", "report"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("expected %v, got %v", expected, order)
+	}
+}
+
+func TestHeap_Empty(t *testing.T) {
+	h := NewHeap(func(a, b string) bool { return a < b })
+
+	if val, ok := h.Extract(); ok || val != "" {
+		t.Errorf("extract from empty heap should fail, got %q", val)
+	}
+	if _, ok := h.Peek(); ok {
+		t.Error("peek on empty heap should fail")
+	}
+	if !h.IsEmpty() || h.Size() != 0 {
+		t.Error("new heap should be empty")
+	}
+}
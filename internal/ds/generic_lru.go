@@ -0,0 +1,156 @@
+package ds
+
+// Why interviewers ask this:
+// LRUCache stores interface{} values keyed by string, so every caller pays a type
+// assertion and can get it wrong at runtime. Rewriting it with type parameters is a
+// natural "how would you modernize this?" follow-up and shows generics aren't only
+// for slice helpers - they fit container types with internal node structs too.
+
+// Common pitfalls:
+// - Forgetting the node type needs the same type parameters as the cache
+// - Returning a typed nil where the zero value of V is needed (V may not be a pointer)
+// - Constraining K to any instead of comparable (map keys must be comparable)
+
+// Key takeaway:
+// The design is unchanged: a map K -> *node for O(1) lookup and a doubly linked list
+// with sentinels for O(1) reordering. Only the types change, and callers get V back
+// directly - no assertions, and mismatched types fail at compile time.
+
+// cacheNode is a node in the Cache's doubly linked list
+type cacheNode[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *cacheNode[K, V]
+	next  *cacheNode[K, V]
+}
+
+// Cache is a generic Least Recently Used cache
+// Time Complexity: Get O(1), Put O(1)
+// Space Complexity: O(capacity)
+type Cache[K comparable, V any] struct {
+	capacity int
+	items    map[K]*cacheNode[K, V]
+	head     *cacheNode[K, V] // Sentinel before the most recently used
+	tail     *cacheNode[K, V] // Sentinel after the least recently used
+}
+
+// NewCache creates a new LRU cache with given capacity
+func NewCache[K comparable, V any](capacity int) *Cache[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	head := &cacheNode[K, V]{}
+	tail := &cacheNode[K, V]{}
+	head.next = tail
+	tail.prev = head
+
+	return &Cache[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*cacheNode[K, V]),
+		head:     head,
+		tail:     tail,
+	}
+}
+
+// Get retrieves a value and marks it most recently used
+// Returns the zero value and false if key doesn't exist
+// Time Complexity: O(1)
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	node, exists := c.items[key]
+	if !exists {
+		var zero V
+		return zero, false
+	}
+
+	c.moveToFront(node)
+	return node.value, true
+}
+
+// Put adds or updates a key-value pair, evicting the least recently used entry if full
+// Time Complexity: O(1)
+func (c *Cache[K, V]) Put(key K, value V) {
+	if node, exists := c.items[key]; exists {
+		node.value = value
+		c.moveToFront(node)
+		return
+	}
+
+	node := &cacheNode[K, V]{key: key, value: value}
+	c.items[key] = node
+	c.addToFront(node)
+
+	if len(c.items) > c.capacity {
+		oldest := c.tail.prev
+		c.removeNode(oldest)
+		delete(c.items, oldest.key)
+	}
+}
+
+// Delete removes a key from the cache
+// Returns true if key was found and deleted
+// Time Complexity: O(1)
+func (c *Cache[K, V]) Delete(key K) bool {
+	node, exists := c.items[key]
+	if !exists {
+		return false
+	}
+
+	c.removeNode(node)
+	delete(c.items, key)
+	return true
+}
+
+// Size returns the current number of items in cache
+func (c *Cache[K, V]) Size() int {
+	return len(c.items)
+}
+
+// Capacity returns the maximum capacity of the cache
+func (c *Cache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Clear removes all items from the cache
+func (c *Cache[K, V]) Clear() {
+	c.items = make(map[K]*cacheNode[K, V])
+	c.head.next = c.tail
+	c.tail.prev = c.head
+}
+
+// GetOldest returns the least recently used key without removing it
+// Returns the zero key and false if cache is empty
+func (c *Cache[K, V]) GetOldest() (K, bool) {
+	if c.tail.prev == c.head {
+		var zero K
+		return zero, false
+	}
+	return c.tail.prev.key, true
+}
+
+// GetNewest returns the most recently used key without removing it
+// Returns the zero key and false if cache is empty
+func (c *Cache[K, V]) GetNewest() (K, bool) {
+	if c.head.next == c.tail {
+		var zero K
+		return zero, false
+	}
+	return c.head.next.key, true
+}
+
+func (c *Cache[K, V]) moveToFront(node *cacheNode[K, V]) {
+	c.removeNode(node)
+	c.addToFront(node)
+}
+
+func (c *Cache[K, V]) addToFront(node *cacheNode[K, V]) {
+	node.next = c.head.next
+	node.prev = c.head
+	c.head.next.prev = node
+	c.head.next = node
+}
+
+func (c *Cache[K, V]) removeNode(node *cacheNode[K, V]) {
+	node.prev.next = node.next
+	node.next.prev = node.prev
+}
@@ -0,0 +1,92 @@
+package ds
+
+import "testing"
+
+func TestCache_IntKeysEvictionOrder(t *testing.T) {
+	cache := NewCache[int, string](3)
+
+	cache.Put(1, "one")
+	cache.Put(2, "two")
+	cache.Put(3, "three")
+	cache.Get(1)         // 2 is now least recently used
+	cache.Put(4, "four") // Evicts 2
+
+	if _, ok := cache.Get(2); ok {
+		t.Error("2 should have been evicted")
+	}
+
+	// Values come back as string, no assertion needed
+	var name string
+	name, ok := cache.Get(1)
+	if !ok || name != "one" {
+		t.Errorf("expected %q, got %q", "one", name)
+	}
+
+	if oldest, _ := cache.GetOldest(); oldest != 3 {
+		t.Errorf("expected oldest 3, got %d", oldest)
+	}
+	if newest, _ := cache.GetNewest(); newest != 1 {
+		t.Errorf("expected newest 1, got %d", newest)
+	}
+	if cache.Size() != 3 || cache.Capacity() != 3 {
+		t.Errorf("expected size 3 capacity 3, got %d and %d", cache.Size(), cache.Capacity())
+	}
+}
+
+func TestCache_StructValues(t *testing.T) {
+	type session struct {
+		user  string
+		hits  int
+		admin bool
+	}
+
+	cache := NewCache[string, session](2)
+	cache.Put("s1", session{user: "ana", hits: 1})
+	cache.Put("s2", session{user: "bo", hits: 5, admin: true})
+
+	// Update in place through the typed value
+	s, _ := cache.Get("s1")
+	s.hits++
+	cache.Put("s1", s)
+
+	cache.Put("s3", session{user: "cy"}) // Evicts s2, the least recently used
+
+	if _, ok := cache.Get("s2"); ok {
+		t.Error("s2 should have been evicted")
+	}
+	if s, _ := cache.Get("s1"); s.user != "ana" || s.hits != 2 {
+		t.Errorf("expected ana with 2 hits, got %+v", s)
+	}
+
+	// Missing keys return the zero value of the struct
+	if s, ok := cache.Get("missing"); ok || s != (session{}) {
+		t.Errorf("expected zero session, got %+v", s)
+	}
+}
+
+func TestCache_DeleteAndClear(t *testing.T) {
+	cache := NewCache[string, int](3)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+
+	if !cache.Delete("a") || cache.Delete("a") {
+		t.Error("delete should succeed once")
+	}
+
+	cache.Clear()
+	if cache.Size() != 0 {
+		t.Errorf("expected size 0 after clear, got %d", cache.Size())
+	}
+	if _, ok := cache.GetOldest(); ok {
+		t.Error("GetOldest on empty cache should fail")
+	}
+	if _, ok := cache.GetNewest(); ok {
+		t.Error("GetNewest on empty cache should fail")
+	}
+
+	// Still usable after clear
+	cache.Put("c", 3)
+	if v, ok := cache.Get("c"); !ok || v != 3 {
+		t.Errorf("expected 3, got %d", v)
+	}
+}
@@ -0,0 +1,93 @@
+package ds
+
+// Why interviewers ask this:
+// Queue.Dequeue returns interface{} and uses nil for "empty", so a queue that legitimately
+// holds nil (or a caller expecting an int) can't tell the two apart. Returning (T, bool)
+// from a generic queue fixes both problems and mirrors Go's comma-ok idiom for maps.
+
+// Common pitfalls:
+// - Returning only T, which makes a stored zero value look like "empty"
+// - Re-slicing items[1:] forever: the backing array never shrinks and still pins old values
+// - Not zeroing the dequeued slot, keeping pointers alive for the GC
+
+// Key takeaway:
+// Track a head index instead of re-slicing, zero each slot as it is dequeued, and compact
+// once the dead prefix dominates the slice. Dequeue is then O(1) amortized and the
+// comma-ok result makes "empty" unambiguous for every element type.
+
+// TypedQueue is a generic FIFO queue
+// (Named TypedQueue because Queue is already the interface{}-based version)
+// Time Complexity: Enqueue O(1) amortized, Dequeue O(1) amortized, Peek O(1)
+// Space Complexity: O(n) where n is the number of elements
+type TypedQueue[T any] struct {
+	items []T
+	head  int // Index of the front element; items[:head] are already dequeued
+}
+
+// NewTypedQueue creates and returns a new empty queue
+func NewTypedQueue[T any]() *TypedQueue[T] {
+	return &TypedQueue[T]{
+		items: make([]T, 0),
+	}
+}
+
+// Enqueue adds an element to the rear of the queue
+// Time Complexity: O(1) amortized
+func (q *TypedQueue[T]) Enqueue(item T) {
+	q.items = append(q.items, item)
+}
+
+// Dequeue removes and returns the front element
+// Returns the zero value and false if queue is empty
+// Time Complexity: O(1) amortized
+func (q *TypedQueue[T]) Dequeue() (T, bool) {
+	var zero T
+	if q.IsEmpty() {
+		return zero, false
+	}
+
+	item := q.items[q.head]
+	q.items[q.head] = zero // Release the reference
+	q.head++
+
+	// Compact once more than half the slice is dead space
+	if q.head > len(q.items)/2 {
+		n := copy(q.items, q.items[q.head:])
+		clear(q.items[n:]) // Stale copies past the new end would pin values too
+		q.items = q.items[:n]
+		q.head = 0
+	}
+
+	return item, true
+}
+
+// Peek returns the front element without removing it
+// Returns the zero value and false if queue is empty
+// Time Complexity: O(1)
+func (q *TypedQueue[T]) Peek() (T, bool) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+
+	return q.items[q.head], true
+}
+
+// IsEmpty returns true if the queue has no elements
+// Time Complexity: O(1)
+func (q *TypedQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Size returns the number of elements in the queue
+// Time Complexity: O(1)
+func (q *TypedQueue[T]) Size() int {
+	return len(q.items) - q.head
+}
+
+// Clear removes all elements from the queue
+// Time Complexity: O(1)
+func (q *TypedQueue[T]) Clear() {
+	q.items = make([]T, 0)
+	q.head = 0
+}
@@ -0,0 +1,98 @@
+package ds
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTypedQueue_IntFIFO(t *testing.T) {
+	q := NewTypedQueue[int]()
+	for i := 1; i <= 5; i++ {
+		q.Enqueue(i)
+	}
+
+	if front, ok := q.Peek(); !ok || front != 1 {
+		t.Errorf("expected peek 1, got %d", front)
+	}
+
+	var result []int
+	for !q.IsEmpty() {
+		v, _ := q.Dequeue()
+		result = append(result, v)
+	}
+
+	expected := []int{1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestTypedQueue_StringInterleaved(t *testing.T) {
+	q := NewTypedQueue[string]()
+	var result []string
+
+	// Interleave so compaction happens while elements are still queued
+	for i, word := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		q.Enqueue(word)
+		if i%3 == 2 {
+			v, _ := q.Dequeue()
+			result = append(result, v)
+		}
+	}
+	for !q.IsEmpty() {
+		v, _ := q.Dequeue()
+		result = append(result, v)
+	}
+
+	expected := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestTypedQueue_ZeroValueVsEmpty(t *testing.T) {
+	q := NewTypedQueue[int]()
+	q.Enqueue(0)
+
+	v, ok := q.Dequeue()
+	if !ok || v != 0 {
+		t.Errorf("expected stored zero value (0, true), got (%d, %v)", v, ok)
+	}
+
+	v, ok = q.Dequeue()
+	if ok {
+		t.Errorf("expected (0, false) on empty queue, got (%d, %v)", v, ok)
+	}
+	if _, ok := q.Peek(); ok {
+		t.Error("peek on empty queue should fail")
+	}
+
+	// Pointers work the same way: a stored nil is still reported as present
+	pq := NewTypedQueue[*int]()
+	pq.Enqueue(nil)
+	if p, ok := pq.Dequeue(); !ok || p != nil {
+		t.Errorf("expected stored nil pointer, got (%v, %v)", p, ok)
+	}
+}
+
+func TestTypedQueue_SizeAndClear(t *testing.T) {
+	q := NewTypedQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	q.Dequeue()
+
+	if q.Size() != 2 {
+		t.Errorf("expected size 2, got %d", q.Size())
+	}
+
+	q.Clear()
+	if !q.IsEmpty() || q.Size() != 0 {
+		t.Error("queue should be empty after clear")
+	}
+
+	q.Enqueue(7)
+	if v, ok := q.Dequeue(); !ok || v != 7 {
+		t.Errorf("expected 7 after clear, got %d", v)
+	}
+}
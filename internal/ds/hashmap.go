@@ -10,12 +10,14 @@ package ds
 // - Not handling collisions properly
 // - Forgetting to resize when load factor is high
 // - Not understanding amortized O(1) vs worst-case O(n)
-// - Integer overflow in hash function
+// - Integer overflow in hash function (use unsigned arithmetic so it wraps predictably)
+// - Taking the modulo inside the hash loop, which discards entropy before it can mix
 
 // Key takeaway:
 // Hash map provides O(1) average case for insert/search/delete using hashing and collision resolution.
 // Common collision strategies: chaining (linked lists) or open addressing (linear/quadratic probing).
-// Load factor determines when to resize. Good hash function distributes keys uniformly.
+// Load factor determines when to resize: grow when dense, shrink when sparse (with a gap
+// between the two thresholds to avoid thrashing). Good hash function distributes keys uniformly.
 
 // HashMapEntry represents a key-value pair in the hash map
 type HashMapEntry struct {
@@ -28,10 +30,12 @@ type HashMapEntry struct {
 // Time Complexity: Average O(1), Worst O(n) for insert/search/delete
 // Space Complexity: O(n) where n is number of entries
 type HashMap struct {
-	buckets    []*HashMapEntry
-	size       int
-	capacity   int
-	loadFactor float64
+	buckets       []*HashMapEntry
+	size          int
+	capacity      int
+	minCapacity   int     // Initial capacity; shrinking never goes below it
+	loadFactor    float64 // Grow when size/capacity exceeds this
+	lowLoadFactor float64 // Shrink when size/capacity drops below this
 }
 
 // NewHashMap creates a new hash map with initial capacity
@@ -41,69 +45,99 @@ func NewHashMap(capacity int) *HashMap {
 	}
 
 	return &HashMap{
-		buckets:    make([]*HashMapEntry, capacity),
-		size:       0,
-		capacity:   capacity,
-		loadFactor: 0.75,
+		buckets:       make([]*HashMapEntry, capacity),
+		size:          0,
+		capacity:      capacity,
+		minCapacity:   capacity,
+		loadFactor:    0.75,
+		lowLoadFactor: 0.25,
 	}
 }
 
-// hash computes the hash value for a key
+// FNV-1a 64-bit parameters
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// hash computes the bucket index for a key using 64-bit FNV-1a
+// The full hash is computed first and reduced modulo capacity only once at the end;
+// reducing inside the loop throws away high bits and clusters keys when capacity is
+// a power of two (which doubling always produces)
 func (hm *HashMap) hash(key string) int {
-	hash := 0
+	var h uint64 = fnvOffset64
 	for i := 0; i < len(key); i++ {
-		hash = (hash*31 + int(key[i])) % hm.capacity
-	}
-	if hash < 0 {
-		hash = -hash
+		h ^= uint64(key[i])
+		h *= fnvPrime64 // Overflow wraps, which is exactly what FNV expects
 	}
-	return hash
+	return int(h % uint64(hm.capacity))
 }
 
 // Put inserts or updates a key-value pair
 // Time Complexity: O(1) average
 func (hm *HashMap) Put(key string, value interface{}) {
-	if float64(hm.size)/float64(hm.capacity) > hm.loadFactor {
-		hm.resize()
+	if entry := hm.find(key); entry != nil {
+		entry.Value = value
+		return
 	}
+	hm.insert(key, value)
+}
 
-	index := hm.hash(key)
-
-	// Check if key already exists
-	current := hm.buckets[index]
-	for current != nil {
-		if current.Key == key {
-			current.Value = value
-			return
-		}
-		current = current.Next
+// Get retrieves the value for a key
+// Returns nil and false if key doesn't exist
+// Time Complexity: O(1) average
+func (hm *HashMap) Get(key string) (interface{}, bool) {
+	if entry := hm.find(key); entry != nil {
+		return entry.Value, true
 	}
+	return nil, false
+}
 
-	// Insert new entry at head of chain
-	newEntry := &HashMapEntry{
-		Key:   key,
-		Value: value,
-		Next:  hm.buckets[index],
+// GetOrDefault returns the value for key, or def if the key doesn't exist
+// Time Complexity: O(1) average
+func (hm *HashMap) GetOrDefault(key string, def interface{}) interface{} {
+	if value, ok := hm.Get(key); ok {
+		return value
 	}
-	hm.buckets[index] = newEntry
-	hm.size++
+	return def
 }
 
-// Get retrieves the value for a key
-// Returns nil and false if key doesn't exist
+// PutIfAbsent stores value only if key is missing
+// Returns the existing value and true if key was present (value is not stored),
+// or value and false if it was inserted
 // Time Complexity: O(1) average
-func (hm *HashMap) Get(key string) (interface{}, bool) {
-	index := hm.hash(key)
-	current := hm.buckets[index]
+func (hm *HashMap) PutIfAbsent(key string, value interface{}) (interface{}, bool) {
+	if entry := hm.find(key); entry != nil {
+		return entry.Value, true
+	}
+	hm.insert(key, value)
+	return value, false
+}
 
-	for current != nil {
+// find returns the entry for key, or nil if it isn't in the map
+func (hm *HashMap) find(key string) *HashMapEntry {
+	for current := hm.buckets[hm.hash(key)]; current != nil; current = current.Next {
 		if current.Key == key {
-			return current.Value, true
+			return current
 		}
-		current = current.Next
 	}
+	return nil
+}
 
-	return nil, false
+// insert adds a new entry at the head of its chain, growing first if over the load factor
+// Caller must have checked that key is not already present
+func (hm *HashMap) insert(key string, value interface{}) {
+	if float64(hm.size)/float64(hm.capacity) > hm.loadFactor {
+		hm.resize(hm.capacity * 2)
+	}
+
+	index := hm.hash(key) // Hashed after any resize, which changes the bucket count
+	hm.buckets[index] = &HashMapEntry{
+		Key:   key,
+		Value: value,
+		Next:  hm.buckets[index],
+	}
+	hm.size++
 }
 
 // Delete removes a key-value pair
@@ -123,6 +157,7 @@ func (hm *HashMap) Delete(key string) bool {
 				prev.Next = current.Next
 			}
 			hm.size--
+			hm.maybeShrink()
 			return true
 		}
 		prev = current
@@ -170,19 +205,71 @@ func (hm *HashMap) Keys() []string {
 	return keys
 }
 
-// resize doubles the capacity and rehashes all entries
-func (hm *HashMap) resize() {
+// Values returns all values in the map, in the same order Keys returns keys
+func (hm *HashMap) Values() []interface{} {
+	values := make([]interface{}, 0, hm.size)
+
+	hm.ForEach(func(_ string, value interface{}) {
+		values = append(values, value)
+	})
+
+	return values
+}
+
+// ForEach calls fn once for every key-value pair, walking each bucket's chain
+// Order is unspecified; fn must not modify the map
+// Time Complexity: O(capacity + n)
+func (hm *HashMap) ForEach(fn func(key string, value interface{})) {
+	for _, bucket := range hm.buckets {
+		current := bucket
+		for current != nil {
+			fn(current.Key, current.Value)
+			current = current.Next
+		}
+	}
+}
+
+// SetLoadFactors sets the grow (high) and shrink (low) thresholds for size/capacity
+// low must be below high/2, otherwise halving the capacity could immediately
+// exceed high and the map would thrash between growing and shrinking
+// Returns false and leaves the thresholds unchanged if the values are invalid
+func (hm *HashMap) SetLoadFactors(high, low float64) bool {
+	if high <= 0 || low < 0 || low >= high/2 {
+		return false
+	}
+
+	hm.loadFactor = high
+	hm.lowLoadFactor = low
+	return true
+}
+
+// maybeShrink halves the capacity once the map is sparse enough
+// Never shrinks below the initial capacity
+func (hm *HashMap) maybeShrink() {
+	if hm.capacity/2 < hm.minCapacity {
+		return
+	}
+	if float64(hm.size)/float64(hm.capacity) < hm.lowLoadFactor {
+		hm.resize(hm.capacity / 2)
+	}
+}
+
+// resize moves every entry into a new bucket array of the given capacity
+// Used both to double (on Put) and to halve (on Delete)
+func (hm *HashMap) resize(newCapacity int) {
 	oldBuckets := hm.buckets
-	hm.capacity *= 2
+	hm.capacity = newCapacity
 	hm.buckets = make([]*HashMapEntry, hm.capacity)
-	hm.size = 0
 
-	// Rehash all entries
+	// Relink the existing entries rather than calling Put, which could trigger another resize
 	for _, bucket := range oldBuckets {
 		current := bucket
 		for current != nil {
-			hm.Put(current.Key, current.Value)
-			current = current.Next
+			next := current.Next
+			index := hm.hash(current.Key)
+			current.Next = hm.buckets[index]
+			hm.buckets[index] = current
+			current = next
 		}
 	}
 }
@@ -1,6 +1,10 @@
 package ds
 
-import "testing"
+import (
+	"sort"
+	"strconv"
+	"testing"
+)
 
 func TestHashMap_PutAndGet(t *testing.T) {
 	hm := NewHashMap(4)
@@ -280,3 +284,217 @@ func TestHashMap_DeleteFromChain(t *testing.T) {
 		t.Error("key 'b' should be deleted")
 	}
 }
+
+func TestHashMap_Values(t *testing.T) {
+	// Capacity 4 with 20 keys forces both chaining and resizes
+	hm := NewHashMap(4)
+	for i := 0; i < 20; i++ {
+		hm.Put("key"+strconv.Itoa(i), i)
+	}
+
+	values := hm.Values()
+	if len(values) != 20 {
+		t.Fatalf("expected 20 values, got %d", len(values))
+	}
+
+	ints := make([]int, len(values))
+	for i, v := range values {
+		ints[i] = v.(int)
+	}
+	sort.Ints(ints)
+	for i, v := range ints {
+		if v != i {
+			t.Fatalf("expected values 0..19, got %v", ints)
+		}
+	}
+
+	// Values lines up with Keys
+	keys := hm.Keys()
+	for i, key := range keys {
+		if expected, _ := hm.Get(key); values[i] != expected {
+			t.Errorf("Values()[%d]: expected %v for key %q, got %v", i, expected, key, values[i])
+		}
+	}
+
+	if len(NewHashMap(4).Values()) != 0 {
+		t.Error("expected no values for empty map")
+	}
+}
+
+func TestHashMap_ForEach(t *testing.T) {
+	hm := NewHashMap(2)
+	for i := 0; i < 10; i++ {
+		hm.Put("k"+strconv.Itoa(i), i*i)
+	}
+	hm.Delete("k3")
+
+	visits := make(map[string]int)
+	hm.ForEach(func(key string, value interface{}) {
+		visits[key]++
+		if expected, _ := hm.Get(key); value != expected {
+			t.Errorf("ForEach(%q): expected value %v, got %v", key, expected, value)
+		}
+	})
+
+	if len(visits) != hm.Size() {
+		t.Errorf("expected %d distinct keys, got %d", hm.Size(), len(visits))
+	}
+	for key, count := range visits {
+		if count != 1 {
+			t.Errorf("key %q visited %d times", key, count)
+		}
+	}
+	if _, ok := visits["k3"]; ok {
+		t.Error("deleted key should not be visited")
+	}
+}
+
+func TestHashMap_HashDistribution(t *testing.T) {
+	hm := NewHashMap(1024)
+	hm.loadFactor = 1e9 // Disable resizing so chains can grow
+
+	n := 10000
+	for i := 0; i < n; i++ {
+		hm.Put("key"+strconv.Itoa(i), i)
+	}
+
+	maxChain := 0
+	for _, bucket := range hm.buckets {
+		length := 0
+		for current := bucket; current != nil; current = current.Next {
+			length++
+		}
+		maxChain = max(maxChain, length)
+	}
+
+	// The old per-character modulo hash produced chains of 33 here
+	average := float64(n) / float64(hm.capacity)
+	if float64(maxChain) >= 2*average {
+		t.Errorf("expected max chain below %.1f (2x average), got %d", 2*average, maxChain)
+	}
+	if hm.Size() != n {
+		t.Errorf("expected size %d, got %d", n, hm.Size())
+	}
+}
+
+func TestHashMap_ShrinksAfterDeletes(t *testing.T) {
+	hm := NewHashMap(8)
+
+	for i := 0; i < 200; i++ {
+		hm.Put("key"+strconv.Itoa(i), i)
+	}
+	grown := hm.capacity
+	if grown < 256 {
+		t.Fatalf("expected several grows, capacity is only %d", grown)
+	}
+
+	// Keep every 20th key
+	for i := 0; i < 200; i++ {
+		if i%20 != 0 {
+			hm.Delete("key" + strconv.Itoa(i))
+		}
+	}
+
+	if hm.capacity >= grown/4 {
+		t.Errorf("expected capacity to shrink well below %d, got %d", grown, hm.capacity)
+	}
+	if hm.Size() != 10 {
+		t.Errorf("expected size 10, got %d", hm.Size())
+	}
+	for i := 0; i < 200; i += 20 {
+		key := "key" + strconv.Itoa(i)
+		if val, ok := hm.Get(key); !ok || val != i {
+			t.Errorf("expected %d for key %s after shrinking, got %v", i, key, val)
+		}
+	}
+
+	// Never below the initial capacity
+	for i := 0; i < 200; i += 20 {
+		hm.Delete("key" + strconv.Itoa(i))
+	}
+	if hm.capacity != 8 {
+		t.Errorf("expected capacity to bottom out at 8, got %d", hm.capacity)
+	}
+}
+
+func TestHashMap_SetLoadFactors(t *testing.T) {
+	hm := NewHashMap(4)
+
+	if hm.SetLoadFactors(0.75, 0.5) {
+		t.Error("low >= high/2 should be rejected")
+	}
+	if hm.SetLoadFactors(0, 0) || hm.SetLoadFactors(0.75, -0.1) {
+		t.Error("non-positive high or negative low should be rejected")
+	}
+	if !hm.SetLoadFactors(2, 0) {
+		t.Fatal("valid load factors should be accepted")
+	}
+
+	// high = 2 lets chains average two entries before growing; low = 0 disables shrinking
+	for i := 0; i < 8; i++ {
+		hm.Put("k"+strconv.Itoa(i), i)
+	}
+	if hm.capacity != 4 {
+		t.Errorf("expected no grow at load factor 2, got capacity %d", hm.capacity)
+	}
+
+	hm.Put("k8", 8)
+	hm.Put("k9", 9)
+	for i := 0; i < 10; i++ {
+		hm.Delete("k" + strconv.Itoa(i))
+	}
+	if hm.capacity != 8 {
+		t.Errorf("expected shrinking disabled at capacity 8, got %d", hm.capacity)
+	}
+}
+
+func TestHashMap_GetOrDefault(t *testing.T) {
+	hm := NewHashMap(4)
+	hm.Put("present", 1)
+	hm.Put("nil", nil)
+
+	if val := hm.GetOrDefault("present", 99); val != 1 {
+		t.Errorf("expected 1, got %v", val)
+	}
+	if val := hm.GetOrDefault("missing", 99); val != 99 {
+		t.Errorf("expected default 99, got %v", val)
+	}
+	// A stored nil is a real value, not a miss
+	if val := hm.GetOrDefault("nil", 99); val != nil {
+		t.Errorf("expected stored nil, got %v", val)
+	}
+	if hm.Contains("missing") {
+		t.Error("GetOrDefault must not insert the default")
+	}
+}
+
+func TestHashMap_PutIfAbsent(t *testing.T) {
+	hm := NewHashMap(2)
+
+	actual, loaded := hm.PutIfAbsent("a", 1)
+	if loaded || actual != 1 {
+		t.Errorf("expected (1, false) for new key, got (%v, %v)", actual, loaded)
+	}
+
+	actual, loaded = hm.PutIfAbsent("a", 2)
+	if !loaded || actual != 1 {
+		t.Errorf("expected (1, true) for existing key, got (%v, %v)", actual, loaded)
+	}
+	if val, _ := hm.Get("a"); val != 1 {
+		t.Errorf("existing value must not be overwritten, got %v", val)
+	}
+
+	// Enough inserts to resize through PutIfAbsent alone
+	for i := 0; i < 20; i++ {
+		hm.PutIfAbsent("k"+strconv.Itoa(i), i)
+	}
+	if hm.Size() != 21 {
+		t.Errorf("expected size 21, got %d", hm.Size())
+	}
+	for i := 0; i < 20; i++ {
+		key := "k" + strconv.Itoa(i)
+		if val, ok := hm.Get(key); !ok || val != i {
+			t.Errorf("expected %d for key %s, got %v", i, key, val)
+		}
+	}
+}
@@ -72,6 +72,35 @@ func (h *MinHeap) Peek() (int, bool) {
 	return h.items[0], true
 }
 
+// Remove deletes the first occurrence of value, which may be anywhere in the heap
+// The last element fills the hole and is sifted up or down, whichever restores the min-heap property
+// Returns false if value is not in the heap
+// Time Complexity: O(n) to find the value, O(log n) to restore the heap
+func (h *MinHeap) Remove(value int) bool {
+	index := -1
+	for i, item := range h.items {
+		if item == value {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return false
+	}
+
+	lastIdx := len(h.items) - 1
+	h.items[index] = h.items[lastIdx]
+	h.items = h.items[:lastIdx]
+
+	if index < len(h.items) {
+		// The moved element may belong above or below its new slot; at most one sift moves it
+		h.heapifyUp(index)
+		h.heapifyDown(index)
+	}
+
+	return true
+}
+
 // heapifyUp maintains heap property by moving element up
 func (h *MinHeap) heapifyUp(index int) {
 	for index > 0 {
@@ -201,6 +230,35 @@ func (h *MaxHeap) Peek() (int, bool) {
 	return h.items[0], true
 }
 
+// Remove deletes the first occurrence of value, which may be anywhere in the heap
+// The last element fills the hole and is sifted up or down, whichever restores the max-heap property
+// Returns false if value is not in the heap
+// Time Complexity: O(n) to find the value, O(log n) to restore the heap
+func (h *MaxHeap) Remove(value int) bool {
+	index := -1
+	for i, item := range h.items {
+		if item == value {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return false
+	}
+
+	lastIdx := len(h.items) - 1
+	h.items[index] = h.items[lastIdx]
+	h.items = h.items[:lastIdx]
+
+	if index < len(h.items) {
+		// The moved element may belong above or below its new slot; at most one sift moves it
+		h.heapifyUp(index)
+		h.heapifyDown(index)
+	}
+
+	return true
+}
+
 // heapifyUp maintains max-heap property by moving element up
 func (h *MaxHeap) heapifyUp(index int) {
 	for index > 0 {
@@ -250,3 +308,27 @@ func (h *MaxHeap) IsEmpty() bool {
 func (h *MaxHeap) Size() int {
 	return len(h.items)
 }
+
+// Clear removes all elements from the heap
+func (h *MaxHeap) Clear() {
+	h.items = make([]int, 0)
+}
+
+// BuildHeap creates a heap from an array of values
+// Time Complexity: O(n)
+func (h *MaxHeap) BuildHeap(values []int) {
+	h.items = make([]int, len(values))
+	copy(h.items, values)
+
+	// Start from last non-leaf node and heapify down
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.heapifyDown(i)
+	}
+}
+
+// ToSlice returns the heap as a slice (not sorted)
+func (h *MaxHeap) ToSlice() []int {
+	result := make([]int, len(h.items))
+	copy(result, h.items)
+	return result
+}
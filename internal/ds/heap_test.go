@@ -269,6 +269,97 @@ func TestMaxHeap_IsEmpty(t *testing.T) {
 	}
 }
 
+func TestMaxHeap_Clear(t *testing.T) {
+	h := NewMaxHeap()
+	h.Insert(1)
+	h.Insert(2)
+	h.Insert(3)
+
+	h.Clear()
+
+	if !h.IsEmpty() {
+		t.Error("heap should be empty after clear")
+	}
+
+	if h.Size() != 0 {
+		t.Errorf("expected size 0 after clear, got %d", h.Size())
+	}
+}
+
+func TestMaxHeap_BuildHeap(t *testing.T) {
+	h := NewMaxHeap()
+
+	values := []int{9, 5, 6, 2, 3, 7, 1, 4, 8}
+	h.BuildHeap(values)
+
+	if h.Size() != len(values) {
+		t.Errorf("expected size %d, got %d", len(values), h.Size())
+	}
+
+	// Verify heap property right after the O(n) build: parent >= children
+	items := h.ToSlice()
+	for i := 0; i < len(items); i++ {
+		leftChild := 2*i + 1
+		rightChild := 2*i + 2
+
+		if leftChild < len(items) && items[i] < items[leftChild] {
+			t.Errorf("heap property violated at index %d", i)
+		}
+
+		if rightChild < len(items) && items[i] < items[rightChild] {
+			t.Errorf("heap property violated at index %d", i)
+		}
+	}
+
+	// Extract all and verify descending order
+	var result []int
+	for !h.IsEmpty() {
+		val, _ := h.ExtractMax()
+		result = append(result, val)
+	}
+
+	expected := []int{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	// BuildHeap copies its input
+	if values[0] != 9 || values[len(values)-1] != 8 {
+		t.Errorf("BuildHeap must not modify its input, got %v", values)
+	}
+}
+
+func TestMaxHeap_HeapProperty(t *testing.T) {
+	h := NewMaxHeap()
+
+	// Insert random values
+	values := []int{15, 10, 20, 8, 25, 30, 5}
+	for _, v := range values {
+		h.Insert(v)
+	}
+
+	// Verify heap property: parent >= children
+	items := h.ToSlice()
+	for i := 0; i < len(items); i++ {
+		leftChild := 2*i + 1
+		rightChild := 2*i + 2
+
+		if leftChild < len(items) && items[i] < items[leftChild] {
+			t.Errorf("heap property violated at index %d", i)
+		}
+
+		if rightChild < len(items) && items[i] < items[rightChild] {
+			t.Errorf("heap property violated at index %d", i)
+		}
+	}
+
+	// ToSlice returns a copy
+	items[0] = -1
+	if top, _ := h.Peek(); top != 30 {
+		t.Errorf("mutating ToSlice result changed the heap, peek = %d", top)
+	}
+}
+
 func TestMinHeap_DuplicateValues(t *testing.T) {
 	h := NewMinHeap()
 
@@ -314,3 +405,102 @@ func TestMinHeap_SingleElement(t *testing.T) {
 		t.Error("heap should be empty")
 	}
 }
+
+func TestMinHeap_Remove(t *testing.T) {
+	h := NewMinHeap()
+	h.BuildHeap([]int{1, 5, 2, 6, 7, 3, 4, 8, 9})
+
+	// 5 is an interior node with children; 9 is the last leaf
+	if !h.Remove(5) {
+		t.Fatal("remove of existing value should succeed")
+	}
+	if !h.Remove(9) {
+		t.Fatal("remove of last element should succeed")
+	}
+	if h.Remove(42) {
+		t.Error("remove of missing value should fail")
+	}
+
+	items := h.ToSlice()
+	for i := 0; i < len(items); i++ {
+		leftChild := 2*i + 1
+		rightChild := 2*i + 2
+
+		if leftChild < len(items) && items[i] > items[leftChild] {
+			t.Errorf("heap property violated at index %d", i)
+		}
+
+		if rightChild < len(items) && items[i] > items[rightChild] {
+			t.Errorf("heap property violated at index %d", i)
+		}
+	}
+
+	var result []int
+	for !h.IsEmpty() {
+		val, _ := h.ExtractMin()
+		result = append(result, val)
+	}
+
+	expected := []int{1, 2, 3, 4, 6, 7, 8}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestMinHeap_RemoveSiftsUp(t *testing.T) {
+	h := NewMinHeap()
+	// Left subtree holds large values, right subtree small ones
+	h.BuildHeap([]int{1, 10, 2, 11, 12, 3, 4})
+
+	// The last element (4) replaces 11 and must move up above 10
+	h.Remove(11)
+
+	if items := h.ToSlice(); items[1] != 4 {
+		t.Errorf("expected 4 to sift up to index 1, got %v", items)
+	}
+}
+
+func TestMaxHeap_Remove(t *testing.T) {
+	h := NewMaxHeap()
+	h.BuildHeap([]int{9, 5, 8, 4, 3, 7, 6, 2, 1})
+
+	if !h.Remove(5) {
+		t.Fatal("remove of existing value should succeed")
+	}
+	if !h.Remove(9) {
+		t.Fatal("remove of root should succeed")
+	}
+	if h.Remove(42) {
+		t.Error("remove of missing value should fail")
+	}
+
+	items := h.ToSlice()
+	for i := 0; i < len(items); i++ {
+		leftChild := 2*i + 1
+		rightChild := 2*i + 2
+
+		if leftChild < len(items) && items[i] < items[leftChild] {
+			t.Errorf("heap property violated at index %d", i)
+		}
+
+		if rightChild < len(items) && items[i] < items[rightChild] {
+			t.Errorf("heap property violated at index %d", i)
+		}
+	}
+
+	var result []int
+	for !h.IsEmpty() {
+		val, _ := h.ExtractMax()
+		result = append(result, val)
+	}
+
+	expected := []int{8, 7, 6, 4, 3, 2, 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	h.Insert(1)
+	if !h.Remove(1) || !h.IsEmpty() {
+		t.Error("removing the only element should empty the heap")
+	}
+}
@@ -0,0 +1,163 @@
+package ds
+
+// Why interviewers ask this:
+// LFU is the harder sibling of LRU: evict the entry used the fewest times, breaking ties
+// by recency, and still do everything in O(1). A heap gives O(log n); the O(1) answer
+// needs one doubly linked list per frequency plus a running minimum frequency.
+
+// Common pitfalls:
+// - Using a heap keyed by frequency (O(log n), and ties by recency get messy)
+// - Forgetting to bump minFreq when the last node leaves the minimum-frequency list
+// - Resetting minFreq on Put of an existing key (it's an access, not an insert)
+// - Counting a Put update as a new entry and evicting when the cache isn't full
+
+// Key takeaway:
+// Map key -> node, and map frequency -> doubly linked list ordered by recency. An access
+// moves a node from list f to the front of list f+1. A new entry starts at frequency 1,
+// so minFreq resets to 1; eviction removes the tail of the minFreq list.
+
+// lfuNode is an entry in one of the frequency lists
+type lfuNode struct {
+	key   string
+	value interface{}
+	freq  int
+	prev  *lfuNode
+	next  *lfuNode
+}
+
+// lfuList is a doubly linked list with sentinels; front is most recently used
+type lfuList struct {
+	head *lfuNode
+	tail *lfuNode
+	size int
+}
+
+func newLFUList() *lfuList {
+	head := &lfuNode{}
+	tail := &lfuNode{}
+	head.next = tail
+	tail.prev = head
+	return &lfuList{head: head, tail: tail}
+}
+
+func (l *lfuList) addToFront(node *lfuNode) {
+	node.next = l.head.next
+	node.prev = l.head
+	l.head.next.prev = node
+	l.head.next = node
+	l.size++
+}
+
+func (l *lfuList) remove(node *lfuNode) {
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	l.size--
+}
+
+// LFUCache implements a Least Frequently Used cache, breaking ties by least recently used
+// Time Complexity: Get O(1), Put O(1)
+// Space Complexity: O(capacity)
+type LFUCache struct {
+	capacity int
+	cache    map[string]*lfuNode
+	freqs    map[int]*lfuList // Access count -> entries with that count
+	minFreq  int
+}
+
+// NewLFUCache creates a new LFU cache with given capacity
+func NewLFUCache(capacity int) *LFUCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &LFUCache{
+		capacity: capacity,
+		cache:    make(map[string]*lfuNode),
+		freqs:    make(map[int]*lfuList),
+	}
+}
+
+// Get retrieves a value from the cache and counts the access
+// Returns nil and false if key doesn't exist
+// Time Complexity: O(1)
+func (lfu *LFUCache) Get(key string) (interface{}, bool) {
+	node, exists := lfu.cache[key]
+	if !exists {
+		return nil, false
+	}
+
+	lfu.touch(node)
+	return node.value, true
+}
+
+// Put adds or updates a key-value pair
+// Updating an existing key counts as an access
+// If cache is at capacity, evicts the least frequently used item
+// Time Complexity: O(1)
+func (lfu *LFUCache) Put(key string, value interface{}) {
+	if node, exists := lfu.cache[key]; exists {
+		node.value = value
+		lfu.touch(node)
+		return
+	}
+
+	if len(lfu.cache) >= lfu.capacity {
+		lfu.evict()
+	}
+
+	node := &lfuNode{key: key, value: value, freq: 1}
+	lfu.cache[key] = node
+	lfu.list(1).addToFront(node)
+	lfu.minFreq = 1 // A brand new entry is always the least frequent
+}
+
+// Size returns the current number of items in cache
+func (lfu *LFUCache) Size() int {
+	return len(lfu.cache)
+}
+
+// Capacity returns the maximum capacity of the cache
+func (lfu *LFUCache) Capacity() int {
+	return lfu.capacity
+}
+
+// touch moves node from its frequency list to the front of the next one
+func (lfu *LFUCache) touch(node *lfuNode) {
+	current := lfu.freqs[node.freq]
+	current.remove(node)
+
+	if current.size == 0 {
+		delete(lfu.freqs, node.freq)
+		if lfu.minFreq == node.freq {
+			lfu.minFreq++ // node was the only one at the minimum, and it just moved up
+		}
+	}
+
+	node.freq++
+	lfu.list(node.freq).addToFront(node)
+}
+
+// evict removes the least recently used entry among the least frequently used
+func (lfu *LFUCache) evict() {
+	list := lfu.freqs[lfu.minFreq]
+	if list == nil {
+		return // Empty cache
+	}
+
+	victim := list.tail.prev
+	list.remove(victim)
+	if list.size == 0 {
+		delete(lfu.freqs, lfu.minFreq)
+	}
+	delete(lfu.cache, victim.key)
+}
+
+// list returns the list for freq, creating it if needed
+func (lfu *LFUCache) list(freq int) *lfuList {
+	l, ok := lfu.freqs[freq]
+	if !ok {
+		l = newLFUList()
+		lfu.freqs[freq] = l
+	}
+	return l
+}
@@ -0,0 +1,110 @@
+package ds
+
+// Why interviewers ask this:
+// Go maps deliberately randomize iteration order, so "iterate in insertion order" needs
+// an extra structure. LinkedHashMap is the general form of the trick behind LRU caches:
+// a hash map for O(1) lookup plus a doubly linked list that remembers order.
+
+// Common pitfalls:
+// - Keeping a separate keys slice (Delete becomes O(n) to find and remove the key)
+// - Moving an existing key to the end on update (that is access order, not insertion order)
+// - Deleting from the map but not unlinking the node (iteration still sees it)
+// - Forgetting sentinel nodes and special-casing empty/head/tail everywhere
+
+// Key takeaway:
+// Map key -> list node, and keep nodes in a doubly linked list with sentinel head/tail.
+// Put appends only new keys; updates change the value in place. Delete unlinks in O(1).
+// Iteration walks the list, so order is stable and deterministic.
+
+// linkedHashEntry is a node in the insertion-order list
+type linkedHashEntry[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *linkedHashEntry[K, V]
+	next  *linkedHashEntry[K, V]
+}
+
+// LinkedHashMap is a hash map that iterates in insertion order
+// Time Complexity: Put, Get, Delete O(1); Keys O(n)
+// Space Complexity: O(n)
+type LinkedHashMap[K comparable, V any] struct {
+	entries map[K]*linkedHashEntry[K, V]
+	head    *linkedHashEntry[K, V] // Sentinel before the oldest entry
+	tail    *linkedHashEntry[K, V] // Sentinel after the newest entry
+}
+
+// NewLinkedHashMap creates an empty LinkedHashMap
+func NewLinkedHashMap[K comparable, V any]() *LinkedHashMap[K, V] {
+	head := &linkedHashEntry[K, V]{}
+	tail := &linkedHashEntry[K, V]{}
+	head.next = tail
+	tail.prev = head
+
+	return &LinkedHashMap[K, V]{
+		entries: make(map[K]*linkedHashEntry[K, V]),
+		head:    head,
+		tail:    tail,
+	}
+}
+
+// Put inserts or updates key
+// Updating an existing key keeps its original position
+// Time Complexity: O(1)
+func (m *LinkedHashMap[K, V]) Put(key K, value V) {
+	if entry, exists := m.entries[key]; exists {
+		entry.value = value
+		return
+	}
+
+	entry := &linkedHashEntry[K, V]{key: key, value: value}
+	m.entries[key] = entry
+
+	// Append before the tail sentinel
+	entry.prev = m.tail.prev
+	entry.next = m.tail
+	m.tail.prev.next = entry
+	m.tail.prev = entry
+}
+
+// Get retrieves the value for key
+// Returns the zero value and false if key doesn't exist
+// Time Complexity: O(1)
+func (m *LinkedHashMap[K, V]) Get(key K) (V, bool) {
+	entry, exists := m.entries[key]
+	if !exists {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Delete removes key
+// Returns true if key was found and deleted
+// Time Complexity: O(1)
+func (m *LinkedHashMap[K, V]) Delete(key K) bool {
+	entry, exists := m.entries[key]
+	if !exists {
+		return false
+	}
+
+	entry.prev.next = entry.next
+	entry.next.prev = entry.prev
+	delete(m.entries, key)
+
+	return true
+}
+
+// Keys returns all keys in insertion order
+// Time Complexity: O(n)
+func (m *LinkedHashMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.entries))
+	for entry := m.head.next; entry != m.tail; entry = entry.next {
+		keys = append(keys, entry.key)
+	}
+	return keys
+}
+
+// Size returns the number of entries
+func (m *LinkedHashMap[K, V]) Size() int {
+	return len(m.entries)
+}
@@ -0,0 +1,78 @@
+package ds
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLinkedHashMap_PutAndGet(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	if val, ok := m.Get("a"); !ok || val != 1 {
+		t.Errorf("expected 1, got %v", val)
+	}
+	if _, ok := m.Get("missing"); ok {
+		t.Error("get should fail for non-existent key")
+	}
+	if m.Size() != 2 {
+		t.Errorf("expected size 2, got %d", m.Size())
+	}
+}
+
+func TestLinkedHashMap_OrderSurvivesUpdates(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 30) // Update keeps original position
+
+	expected := []string{"c", "a", "b"}
+	if !reflect.DeepEqual(m.Keys(), expected) {
+		t.Errorf("expected %v, got %v", expected, m.Keys())
+	}
+
+	if val, _ := m.Get("c"); val != 30 {
+		t.Errorf("expected updated value 30, got %d", val)
+	}
+}
+
+func TestLinkedHashMap_DeleteReflectedInOrder(t *testing.T) {
+	m := NewLinkedHashMap[int, string]()
+	for i := 1; i <= 5; i++ {
+		m.Put(i, "v")
+	}
+
+	if !m.Delete(1) || !m.Delete(3) || !m.Delete(5) {
+		t.Fatal("expected deletes of existing keys to succeed")
+	}
+	if m.Delete(3) {
+		t.Error("second delete of same key should fail")
+	}
+
+	expected := []int{2, 4}
+	if !reflect.DeepEqual(m.Keys(), expected) {
+		t.Errorf("expected %v, got %v", expected, m.Keys())
+	}
+
+	// Re-inserting a deleted key appends it at the end
+	m.Put(1, "again")
+	expected = []int{2, 4, 1}
+	if !reflect.DeepEqual(m.Keys(), expected) {
+		t.Errorf("expected %v, got %v", expected, m.Keys())
+	}
+}
+
+func TestLinkedHashMap_Empty(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+
+	if len(m.Keys()) != 0 {
+		t.Errorf("expected no keys, got %v", m.Keys())
+	}
+	if m.Delete("x") {
+		t.Error("delete on empty map should fail")
+	}
+}
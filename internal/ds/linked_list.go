@@ -1,5 +1,7 @@
 package ds
 
+import "iter"
+
 // Why interviewers ask this:
 // Linked lists are fundamental for understanding pointer manipulation, dynamic memory allocation,
 // and the trade-offs between array-based and pointer-based data structures. Many interview
@@ -156,20 +158,31 @@ func (ll *LinkedList) DeleteAtTail() (interface{}, bool) {
 
 // DeleteValue removes the first occurrence of the value
 // Returns true if value was found and deleted
+// Values are compared with ==, which panics if they hold non-comparable types
+// such as slices or maps; use DeleteValueFunc for those
 // Time Complexity: O(n)
 func (ll *LinkedList) DeleteValue(value interface{}) bool {
+	return ll.DeleteValueFunc(func(v interface{}) bool {
+		return v == value
+	})
+}
+
+// DeleteValueFunc removes the first value for which match returns true
+// Returns true if a value was deleted
+// Time Complexity: O(n)
+func (ll *LinkedList) DeleteValueFunc(match func(interface{}) bool) bool {
 	if ll.head == nil {
 		return false
 	}
 
-	if ll.head.Value == value {
+	if match(ll.head.Value) {
 		ll.DeleteAtHead()
 		return true
 	}
 
 	current := ll.head
 	for current.Next != nil {
-		if current.Next.Value == value {
+		if match(current.Next.Value) {
 			// Found the value
 			if current.Next == ll.tail {
 				ll.tail = current
@@ -186,12 +199,22 @@ func (ll *LinkedList) DeleteValue(value interface{}) bool {
 
 // Search finds the first occurrence of a value
 // Returns true if found
+// Values are compared with ==, which panics if they hold non-comparable types
+// such as slices or maps; use SearchFunc for those
 // Time Complexity: O(n)
 func (ll *LinkedList) Search(value interface{}) bool {
+	return ll.SearchFunc(func(v interface{}) bool {
+		return v == value
+	})
+}
+
+// SearchFunc reports whether any value satisfies match
+// Time Complexity: O(n)
+func (ll *LinkedList) SearchFunc(match func(interface{}) bool) bool {
 	current := ll.head
 
 	for current != nil {
-		if current.Value == value {
+		if match(current.Value) {
 			return true
 		}
 		current = current.Next
@@ -216,6 +239,35 @@ func (ll *LinkedList) Get(position int) (interface{}, bool) {
 	return current.Value, true
 }
 
+// GetNthFromEnd returns the value n positions from the end (n=1 is the tail)
+// Single pass with two pointers n nodes apart; does not rely on size
+// Returns nil and false if n is out of range or the list is empty
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func (ll *LinkedList) GetNthFromEnd(n int) (interface{}, bool) {
+	if n < 1 {
+		return nil, false
+	}
+
+	// Move lead n nodes ahead of trail
+	lead := ll.head
+	for i := 0; i < n; i++ {
+		if lead == nil {
+			return nil, false // Fewer than n nodes
+		}
+		lead = lead.Next
+	}
+
+	// When lead falls off the end, trail is n nodes from it
+	trail := ll.head
+	for lead != nil {
+		lead = lead.Next
+		trail = trail.Next
+	}
+
+	return trail.Value, true
+}
+
 // Reverse reverses the linked list in place
 // Time Complexity: O(n)
 // Space Complexity: O(1)
@@ -238,6 +290,109 @@ func (ll *LinkedList) Reverse() {
 	ll.head = prev
 }
 
+// ReverseInGroups reverses every consecutive group of k nodes in place
+// A trailing group with fewer than k nodes is left unchanged
+// Example: 1->2->3->4->5 with k=2 becomes 2->1->4->3->5
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func (ll *LinkedList) ReverseInGroups(k int) {
+	if k <= 1 || ll.size < k {
+		return
+	}
+
+	dummy := &Node{Next: ll.head}
+	groupPrev := dummy // Node just before the current group
+
+	for remaining := ll.size; remaining >= k; remaining -= k {
+		groupStart := groupPrev.Next
+
+		// Reverse k nodes starting at groupStart
+		var prev *Node
+		current := groupStart
+		for i := 0; i < k; i++ {
+			next := current.Next
+			current.Next = prev
+			prev = current
+			current = next
+		}
+
+		// prev is the new group head; groupStart is now the group tail
+		groupPrev.Next = prev
+		groupStart.Next = current
+		groupPrev = groupStart
+	}
+
+	ll.head = dummy.Next
+
+	// The tail only moves if the last group was reversed
+	if groupPrev.Next == nil {
+		ll.tail = groupPrev
+	}
+}
+
+// SortFunc sorts the list in place with merge sort by rewiring Next pointers
+// The sort is stable: equal values keep their relative order
+// Time Complexity: O(n log n)
+// Space Complexity: O(log n) for the recursion stack, no new nodes
+func (ll *LinkedList) SortFunc(less func(a, b interface{}) bool) {
+	if ll.head == nil || ll.head.Next == nil {
+		return
+	}
+
+	ll.head = mergeSortNodes(ll.head, less)
+
+	// The old tail may now be anywhere; find the new one
+	current := ll.head
+	for current.Next != nil {
+		current = current.Next
+	}
+	ll.tail = current
+}
+
+// mergeSortNodes sorts the chain starting at head and returns the new head
+func mergeSortNodes(head *Node, less func(a, b interface{}) bool) *Node {
+	if head == nil || head.Next == nil {
+		return head
+	}
+
+	// Find the middle with slow/fast pointers; slow ends at the last node of the first half
+	slow, fast := head, head.Next
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+
+	second := slow.Next
+	slow.Next = nil // Split into two chains
+
+	return mergeNodes(mergeSortNodes(head, less), mergeSortNodes(second, less), less)
+}
+
+// mergeNodes merges two sorted chains, preferring a on ties to keep the sort stable
+func mergeNodes(a, b *Node, less func(a, b interface{}) bool) *Node {
+	dummy := &Node{}
+	tail := dummy
+
+	for a != nil && b != nil {
+		if less(b.Value, a.Value) {
+			tail.Next = b
+			b = b.Next
+		} else {
+			tail.Next = a
+			a = a.Next
+		}
+		tail = tail.Next
+	}
+
+	if a != nil {
+		tail.Next = a
+	} else {
+		tail.Next = b
+	}
+
+	return dummy.Next
+}
+
 // ToSlice converts the linked list to a slice
 // Time Complexity: O(n)
 func (ll *LinkedList) ToSlice() []interface{} {
@@ -252,6 +407,30 @@ func (ll *LinkedList) ToSlice() []interface{} {
 	return result
 }
 
+// ForEach calls fn for each value in order, stopping early if fn returns false
+// Unlike ToSlice, it walks the nodes in place without copying them into a slice
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func (ll *LinkedList) ForEach(fn func(index int, value interface{}) bool) {
+	index := 0
+	for current := ll.head; current != nil; current = current.Next {
+		if !fn(index, current.Value) {
+			return
+		}
+		index++
+	}
+}
+
+// All returns a range-over-func iterator of (index, value) pairs
+// Usage: for i, v := range ll.All() { ... } - a break stops the walk immediately
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+func (ll *LinkedList) All() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		ll.ForEach(yield)
+	}
+}
+
 // IsEmpty returns true if the list has no nodes
 func (ll *LinkedList) IsEmpty() bool {
 	return ll.head == nil
@@ -268,3 +447,78 @@ func (ll *LinkedList) Clear() {
 	ll.tail = nil
 	ll.size = 0
 }
+
+// AddTwoNumbers adds two non-negative integers stored as linked lists of int digits,
+// least-significant digit first (342 is stored as 2 -> 4 -> 3), and returns the sum
+// as a new list in the same format. A nil or empty list is treated as zero.
+// Time Complexity: O(max(n, m))
+// Space Complexity: O(max(n, m)) for the result
+func AddTwoNumbers(l1, l2 *LinkedList) *LinkedList {
+	result := NewLinkedList()
+
+	var a, b *Node
+	if l1 != nil {
+		a = l1.head
+	}
+	if l2 != nil {
+		b = l2.head
+	}
+
+	carry := 0
+	for a != nil || b != nil || carry > 0 {
+		sum := carry
+
+		// Lists may differ in length; a missing digit counts as 0
+		if a != nil {
+			sum += a.Value.(int)
+			a = a.Next
+		}
+		if b != nil {
+			sum += b.Value.(int)
+			b = b.Next
+		}
+
+		result.InsertAtTail(sum % 10)
+		carry = sum / 10
+	}
+
+	return result
+}
+
+// MergeSorted merges two lists already sorted by less into a new sorted list
+// The inputs are not modified. The merge is stable: when values compare equal,
+// those from a come before those from b. A nil list is treated as empty.
+// Time Complexity: O(n + m)
+// Space Complexity: O(n + m) for the new nodes
+func MergeSorted(a, b *LinkedList, less func(x, y interface{}) bool) *LinkedList {
+	result := NewLinkedList()
+
+	var x, y *Node
+	if a != nil {
+		x = a.head
+	}
+	if b != nil {
+		y = b.head
+	}
+
+	for x != nil && y != nil {
+		// Take from b only if strictly smaller, which keeps equal values from a first
+		if less(y.Value, x.Value) {
+			result.InsertAtTail(y.Value)
+			y = y.Next
+		} else {
+			result.InsertAtTail(x.Value)
+			x = x.Next
+		}
+	}
+
+	// At most one list has values left
+	for ; x != nil; x = x.Next {
+		result.InsertAtTail(x.Value)
+	}
+	for ; y != nil; y = y.Next {
+		result.InsertAtTail(y.Value)
+	}
+
+	return result
+}
@@ -346,3 +346,393 @@ func TestLinkedList_MixedOperations(t *testing.T) {
 		t.Errorf("expected %v, got %v", expected, ll.ToSlice())
 	}
 }
+
+func digitsList(digits ...int) *LinkedList {
+	ll := NewLinkedList()
+	for _, d := range digits {
+		ll.InsertAtTail(d)
+	}
+	return ll
+}
+
+func TestLinkedList_AddTwoNumbers(t *testing.T) {
+	// 342 + 465 = 807, stored least-significant digit first
+	result := AddTwoNumbers(digitsList(2, 4, 3), digitsList(5, 6, 4))
+
+	expected := []interface{}{7, 0, 8}
+	if !reflect.DeepEqual(result.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, result.ToSlice())
+	}
+}
+
+func TestLinkedList_AddTwoNumbersCarryExtendsLength(t *testing.T) {
+	// 99 + 1 = 100
+	result := AddTwoNumbers(digitsList(9, 9), digitsList(1))
+
+	expected := []interface{}{0, 0, 1}
+	if !reflect.DeepEqual(result.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, result.ToSlice())
+	}
+	if result.Size() != 3 {
+		t.Errorf("expected size 3, got %d", result.Size())
+	}
+}
+
+func TestLinkedList_AddTwoNumbersEmptyList(t *testing.T) {
+	result := AddTwoNumbers(digitsList(1, 2, 3), NewLinkedList())
+
+	expected := []interface{}{1, 2, 3}
+	if !reflect.DeepEqual(result.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, result.ToSlice())
+	}
+
+	if !AddTwoNumbers(NewLinkedList(), nil).IsEmpty() {
+		t.Error("sum of two empty lists should be empty")
+	}
+}
+
+func TestLinkedList_ReverseInGroups(t *testing.T) {
+	tests := []struct {
+		k        int
+		expected []interface{}
+		tail     interface{}
+	}{
+		{2, []interface{}{2, 1, 4, 3, 5}, 5},
+		{3, []interface{}{3, 2, 1, 4, 5}, 5},
+		{5, []interface{}{5, 4, 3, 2, 1}, 1},
+		{1, []interface{}{1, 2, 3, 4, 5}, 5},
+		{6, []interface{}{1, 2, 3, 4, 5}, 5}, // k larger than list: no change
+	}
+
+	for _, tt := range tests {
+		ll := digitsList(1, 2, 3, 4, 5)
+		ll.ReverseInGroups(tt.k)
+
+		if !reflect.DeepEqual(ll.ToSlice(), tt.expected) {
+			t.Errorf("ReverseInGroups(%d): expected %v, got %v", tt.k, tt.expected, ll.ToSlice())
+		}
+		if ll.Size() != 5 {
+			t.Errorf("ReverseInGroups(%d): expected size 5, got %d", tt.k, ll.Size())
+		}
+		if ll.tail.Value != tt.tail || ll.tail.Next != nil {
+			t.Errorf("ReverseInGroups(%d): expected tail %v, got %v", tt.k, tt.tail, ll.tail.Value)
+		}
+	}
+}
+
+func TestLinkedList_ReverseInGroupsTailStaysConsistent(t *testing.T) {
+	ll := digitsList(1, 2, 3, 4)
+	ll.ReverseInGroups(2) // 2 1 4 3
+
+	// Appending must go after the new tail
+	ll.InsertAtTail(9)
+
+	expected := []interface{}{2, 1, 4, 3, 9}
+	if !reflect.DeepEqual(ll.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, ll.ToSlice())
+	}
+}
+
+func intLess(x, y interface{}) bool {
+	return x.(int) < y.(int)
+}
+
+func TestLinkedList_MergeSorted(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []int
+		expected []interface{}
+	}{
+		{"interleaved", []int{1, 3, 5}, []int{2, 4, 6, 8}, []interface{}{1, 2, 3, 4, 5, 6, 8}},
+		{"a empty", []int{}, []int{1, 2}, []interface{}{1, 2}},
+		{"b empty", []int{3, 4}, []int{}, []interface{}{3, 4}},
+		{"both empty", []int{}, []int{}, []interface{}{}},
+		{"a entirely before b", []int{1, 2}, []int{5, 6}, []interface{}{1, 2, 5, 6}},
+	}
+
+	for _, tt := range tests {
+		a, b := digitsList(tt.a...), digitsList(tt.b...)
+		merged := MergeSorted(a, b, intLess)
+
+		if !reflect.DeepEqual(merged.ToSlice(), tt.expected) {
+			t.Errorf("MergeSorted(%s): expected %v, got %v", tt.name, tt.expected, merged.ToSlice())
+		}
+		if merged.Size() != len(tt.expected) {
+			t.Errorf("MergeSorted(%s): expected size %d, got %d", tt.name, len(tt.expected), merged.Size())
+		}
+	}
+}
+
+func TestLinkedList_MergeSortedLeavesInputsUntouched(t *testing.T) {
+	a, b := digitsList(1, 4, 7), digitsList(2, 3)
+
+	merged := MergeSorted(a, b, intLess)
+	merged.InsertAtTail(99) // Must not leak into either input
+
+	if a.Size() != 3 || !reflect.DeepEqual(a.ToSlice(), []interface{}{1, 4, 7}) {
+		t.Errorf("expected a unchanged, got %v (size %d)", a.ToSlice(), a.Size())
+	}
+	if b.Size() != 2 || !reflect.DeepEqual(b.ToSlice(), []interface{}{2, 3}) {
+		t.Errorf("expected b unchanged, got %v (size %d)", b.ToSlice(), b.Size())
+	}
+}
+
+func TestLinkedList_MergeSortedIsStable(t *testing.T) {
+	type item struct {
+		key    int
+		source string
+	}
+	byKey := func(x, y interface{}) bool {
+		return x.(item).key < y.(item).key
+	}
+
+	a := NewLinkedList()
+	for _, k := range []int{1, 2, 2} {
+		a.InsertAtTail(item{k, "a"})
+	}
+	b := NewLinkedList()
+	for _, k := range []int{2, 3} {
+		b.InsertAtTail(item{k, "b"})
+	}
+
+	merged := MergeSorted(a, b, byKey)
+
+	expected := []interface{}{
+		item{1, "a"}, item{2, "a"}, item{2, "a"}, item{2, "b"}, item{3, "b"},
+	}
+	if !reflect.DeepEqual(merged.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, merged.ToSlice())
+	}
+}
+
+func TestLinkedList_FuncVariantsHandleNonComparableValues(t *testing.T) {
+	ll := NewLinkedList()
+	ll.InsertAtTail([]int{1, 2})
+	ll.InsertAtTail([]int{3, 4})
+	ll.InsertAtTail([]int{5})
+
+	target := []int{3, 4}
+	matchTarget := func(v interface{}) bool {
+		return reflect.DeepEqual(v, target)
+	}
+
+	if !ll.SearchFunc(matchTarget) {
+		t.Error("SearchFunc should find []int{3, 4}")
+	}
+	if ll.SearchFunc(func(v interface{}) bool { return len(v.([]int)) > 2 }) {
+		t.Error("SearchFunc should not match anything longer than 2")
+	}
+
+	if !ll.DeleteValueFunc(matchTarget) {
+		t.Error("DeleteValueFunc should delete []int{3, 4}")
+	}
+	expected := []interface{}{[]int{1, 2}, []int{5}}
+	if !reflect.DeepEqual(ll.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, ll.ToSlice())
+	}
+	if ll.Size() != 2 {
+		t.Errorf("expected size 2, got %d", ll.Size())
+	}
+
+	// Deleting the tail keeps appends working
+	ll.DeleteValueFunc(func(v interface{}) bool { return reflect.DeepEqual(v, []int{5}) })
+	ll.InsertAtTail([]int{6})
+	expected = []interface{}{[]int{1, 2}, []int{6}}
+	if !reflect.DeepEqual(ll.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, ll.ToSlice())
+	}
+}
+
+func TestLinkedList_EqualityMethodsPanicOnNonComparableValues(t *testing.T) {
+	ll := NewLinkedList()
+	ll.InsertAtTail([]int{1, 2})
+
+	assertPanics := func(name string, fn func()) {
+		t.Helper()
+		defer func() {
+			if recover() == nil {
+				t.Errorf("%s: expected panic comparing slices with ==", name)
+			}
+		}()
+		fn()
+	}
+
+	assertPanics("Search", func() { ll.Search([]int{1, 2}) })
+	assertPanics("DeleteValue", func() { ll.DeleteValue([]int{1, 2}) })
+}
+
+func TestLinkedList_SortFunc(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected []interface{}
+	}{
+		{"reverse ordered", []int{5, 4, 3, 2, 1}, []interface{}{1, 2, 3, 4, 5}},
+		{"already sorted", []int{1, 2, 3, 4}, []interface{}{1, 2, 3, 4}},
+		{"duplicates", []int{3, 1, 3, 2, 1, 2}, []interface{}{1, 1, 2, 2, 3, 3}},
+		{"single element", []int{7}, []interface{}{7}},
+		{"two elements", []int{2, 1}, []interface{}{1, 2}},
+	}
+
+	for _, tt := range tests {
+		ll := digitsList(tt.values...)
+		ll.SortFunc(intLess)
+
+		if !reflect.DeepEqual(ll.ToSlice(), tt.expected) {
+			t.Errorf("SortFunc(%s): expected %v, got %v", tt.name, tt.expected, ll.ToSlice())
+		}
+		if ll.Size() != len(tt.expected) {
+			t.Errorf("SortFunc(%s): expected size %d, got %d", tt.name, len(tt.expected), ll.Size())
+		}
+		last := tt.expected[len(tt.expected)-1]
+		if ll.tail.Value != last || ll.tail.Next != nil {
+			t.Errorf("SortFunc(%s): expected tail %v, got %v", tt.name, last, ll.tail.Value)
+		}
+	}
+}
+
+func TestLinkedList_SortFuncIsStableAndKeepsTailUsable(t *testing.T) {
+	type item struct {
+		key   int
+		label string
+	}
+
+	ll := NewLinkedList()
+	for _, it := range []item{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}} {
+		ll.InsertAtTail(it)
+	}
+
+	ll.SortFunc(func(a, b interface{}) bool {
+		return a.(item).key < b.(item).key
+	})
+	ll.InsertAtTail(item{3, "e"})
+
+	expected := []interface{}{item{1, "b"}, item{1, "d"}, item{2, "a"}, item{2, "c"}, item{3, "e"}}
+	if !reflect.DeepEqual(ll.ToSlice(), expected) {
+		t.Errorf("expected %v, got %v", expected, ll.ToSlice())
+	}
+}
+
+func TestLinkedList_SortFuncEmpty(t *testing.T) {
+	ll := NewLinkedList()
+	ll.SortFunc(intLess)
+
+	if !ll.IsEmpty() || ll.tail != nil {
+		t.Error("sorting an empty list should leave it empty")
+	}
+}
+
+func TestLinkedList_GetNthFromEnd(t *testing.T) {
+	ll := digitsList(10, 20, 30, 40, 50)
+
+	tests := []struct {
+		n        int
+		expected interface{}
+		ok       bool
+	}{
+		{1, 50, true},
+		{2, 40, true},
+		{5, 10, true},
+		{6, nil, false},
+		{0, nil, false},
+		{-1, nil, false},
+	}
+
+	for _, tt := range tests {
+		val, ok := ll.GetNthFromEnd(tt.n)
+		if val != tt.expected || ok != tt.ok {
+			t.Errorf("GetNthFromEnd(%d): expected (%v, %v), got (%v, %v)", tt.n, tt.expected, tt.ok, val, ok)
+		}
+	}
+
+	if _, ok := NewLinkedList().GetNthFromEnd(1); ok {
+		t.Error("GetNthFromEnd on empty list should fail")
+	}
+}
+
+func TestLinkedList_GetNthFromEndIgnoresSize(t *testing.T) {
+	// Wire nodes by hand so size stays 0 and tail stays nil
+	ll := NewLinkedList()
+	ll.head = &Node{Value: "a", Next: &Node{Value: "b", Next: &Node{Value: "c"}}}
+
+	if val, ok := ll.GetNthFromEnd(1); !ok || val != "c" {
+		t.Errorf("expected c, got %v (ok=%v)", val, ok)
+	}
+	if val, ok := ll.GetNthFromEnd(3); !ok || val != "a" {
+		t.Errorf("expected a, got %v (ok=%v)", val, ok)
+	}
+	if _, ok := ll.GetNthFromEnd(4); ok {
+		t.Error("expected n beyond the real length to fail")
+	}
+
+	// A stale size larger than the real length must not be trusted either
+	ll.size = 10
+	if _, ok := ll.GetNthFromEnd(5); ok {
+		t.Error("expected n beyond the real length to fail despite stale size")
+	}
+}
+
+func TestLinkedList_ForEach(t *testing.T) {
+	ll := NewLinkedList()
+	for _, v := range []int{10, 20, 30, 40, 50} {
+		ll.InsertAtTail(v)
+	}
+
+	var visited []interface{}
+	lastIndex := -1
+	ll.ForEach(func(index int, value interface{}) bool {
+		visited = append(visited, value)
+		lastIndex = index
+		return value != 30
+	})
+
+	expected := []interface{}{10, 20, 30}
+	if !reflect.DeepEqual(visited, expected) {
+		t.Errorf("expected %v, got %v", expected, visited)
+	}
+	if lastIndex != 2 {
+		t.Errorf("expected iteration to stop at index 2, got %d", lastIndex)
+	}
+
+	calls := 0
+	NewLinkedList().ForEach(func(int, interface{}) bool {
+		calls++
+		return true
+	})
+	if calls != 0 {
+		t.Errorf("expected no calls on empty list, got %d", calls)
+	}
+}
+
+func TestLinkedList_All(t *testing.T) {
+	ll := NewLinkedList()
+	for _, v := range []string{"a", "b", "c", "d"} {
+		ll.InsertAtTail(v)
+	}
+
+	var indices []int
+	var values []interface{}
+	for i, v := range ll.All() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+
+	if !reflect.DeepEqual(indices, []int{0, 1, 2, 3}) {
+		t.Errorf("expected indices [0 1 2 3], got %v", indices)
+	}
+	if !reflect.DeepEqual(values, ll.ToSlice()) {
+		t.Errorf("expected %v, got %v", ll.ToSlice(), values)
+	}
+
+	// break must stop the iterator without panicking
+	var seen []interface{}
+	for i, v := range ll.All() {
+		if i == 2 {
+			break
+		}
+		seen = append(seen, v)
+	}
+	if !reflect.DeepEqual(seen, []interface{}{"a", "b"}) {
+		t.Errorf("expected [a b], got %v", seen)
+	}
+}
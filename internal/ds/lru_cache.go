@@ -31,10 +31,12 @@ type LRUNode struct {
 // Time Complexity: Get O(1), Put O(1)
 // Space Complexity: O(capacity)
 type LRUCache struct {
-	capacity int
-	cache    map[string]*LRUNode
-	head     *LRUNode // Most recently used
-	tail     *LRUNode // Least recently used
+	capacity       int
+	cache          map[string]*LRUNode
+	head           *LRUNode // Most recently used
+	tail           *LRUNode // Least recently used
+	onEvict        func(key string, value interface{})
+	notifyExplicit bool // Also call onEvict for Delete and Clear
 }
 
 // NewLRUCache creates a new LRU cache with given capacity
@@ -113,9 +115,26 @@ func (lru *LRUCache) Delete(key string) bool {
 	lru.removeNode(node)
 	delete(lru.cache, key)
 
+	if lru.notifyExplicit && lru.onEvict != nil {
+		lru.onEvict(node.Key, node.Value)
+	}
+
 	return true
 }
 
+// OnEvict registers fn to be called with each entry evicted to make room for a new one,
+// e.g. to close a file handle stored as the value. Pass nil to remove the callback
+// The callback runs after the entry is removed and must not call back into the cache
+func (lru *LRUCache) OnEvict(fn func(key string, value interface{})) {
+	lru.onEvict = fn
+}
+
+// NotifyExplicitRemovals controls whether Delete and Clear also invoke the OnEvict
+// callback (off by default, since the caller already knows what it removed)
+func (lru *LRUCache) NotifyExplicitRemovals(enabled bool) {
+	lru.notifyExplicit = enabled
+}
+
 // Size returns the current number of items in cache
 func (lru *LRUCache) Size() int {
 	return len(lru.cache)
@@ -128,6 +147,13 @@ func (lru *LRUCache) Capacity() int {
 
 // Clear removes all items from the cache
 func (lru *LRUCache) Clear() {
+	if lru.notifyExplicit && lru.onEvict != nil {
+		// Notify in eviction order: least recently used first
+		for node := lru.tail.Prev; node != lru.head; node = node.Prev {
+			lru.onEvict(node.Key, node.Value)
+		}
+	}
+
 	lru.cache = make(map[string]*LRUNode)
 	lru.head.Next = lru.tail
 	lru.tail.Prev = lru.head
@@ -142,6 +168,16 @@ func (lru *LRUCache) Keys() []string {
 	return keys
 }
 
+// KeysByRecency returns keys from most recently used to least recently used
+// Time Complexity: O(n)
+func (lru *LRUCache) KeysByRecency() []string {
+	keys := make([]string, 0, len(lru.cache))
+	for node := lru.head.Next; node != lru.tail; node = node.Next {
+		keys = append(keys, node.Key)
+	}
+	return keys
+}
+
 // moveToFront moves a node to the front of the list (most recently used)
 func (lru *LRUCache) moveToFront(node *LRUNode) {
 	lru.removeNode(node)
@@ -171,6 +207,10 @@ func (lru *LRUCache) evictLRU() {
 
 	lru.removeNode(lruNode)
 	delete(lru.cache, lruNode.Key)
+
+	if lru.onEvict != nil {
+		lru.onEvict(lruNode.Key, lruNode.Value)
+	}
 }
 
 // GetOldest returns the least recently used key without removing it
@@ -1,6 +1,9 @@
 package ds
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestLRUCache_PutAndGet(t *testing.T) {
 	cache := NewLRUCache(3)
@@ -346,3 +349,98 @@ func TestLRUCache_PutUpdatesOrder(t *testing.T) {
 		t.Errorf("expected 10, got %v", val)
 	}
 }
+
+func TestLRUCache_OnEvict(t *testing.T) {
+	cache := NewLRUCache(2)
+
+	var evicted []string
+	var values []interface{}
+	cache.OnEvict(func(key string, value interface{}) {
+		evicted = append(evicted, key)
+		values = append(values, value)
+	})
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("a")    // b is now least recently used
+	cache.Put("c", 3) // Evicts b
+	cache.Put("d", 4) // Evicts a
+	cache.Put("c", 30)
+
+	expected := []string{"b", "a"}
+	if !reflect.DeepEqual(evicted, expected) {
+		t.Errorf("expected evictions %v, got %v", expected, evicted)
+	}
+	if !reflect.DeepEqual(values, []interface{}{2, 1}) {
+		t.Errorf("expected evicted values [2 1], got %v", values)
+	}
+
+	// Explicit removals don't notify by default
+	cache.Delete("c")
+	cache.Clear()
+	if len(evicted) != 2 {
+		t.Errorf("Delete and Clear should not notify by default, got %v", evicted)
+	}
+}
+
+func TestLRUCache_OnEvictExplicitRemovals(t *testing.T) {
+	cache := NewLRUCache(3)
+
+	var evicted []string
+	cache.OnEvict(func(key string, _ interface{}) {
+		evicted = append(evicted, key)
+	})
+	cache.NotifyExplicitRemovals(true)
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Put("d", 4) // Evicts a
+
+	cache.Delete("c")
+	cache.Delete("missing")
+	cache.Clear() // b is older than d
+
+	expected := []string{"a", "c", "b", "d"}
+	if !reflect.DeepEqual(evicted, expected) {
+		t.Errorf("expected %v, got %v", expected, evicted)
+	}
+
+	cache.OnEvict(nil)
+	cache.Put("x", 1)
+	cache.Delete("x") // Must not panic without a callback
+}
+
+func TestLRUCache_KeysByRecency(t *testing.T) {
+	cache := NewLRUCache(4)
+
+	if keys := cache.KeysByRecency(); len(keys) != 0 {
+		t.Errorf("expected no keys for empty cache, got %v", keys)
+	}
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+
+	expected := []string{"c", "b", "a"}
+	if keys := cache.KeysByRecency(); !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v, got %v", expected, keys)
+	}
+
+	cache.Get("a")     // a to front
+	cache.Put("b", 20) // Update moves b to front
+	cache.Get("missing")
+	cache.Put("d", 4)
+	cache.Put("e", 5) // Evicts c, now the least recent
+
+	expected = []string{"e", "d", "b", "a"}
+	if keys := cache.KeysByRecency(); !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v, got %v", expected, keys)
+	}
+
+	cache.Delete("d")
+	expected = []string{"e", "b", "a"}
+	if keys := cache.KeysByRecency(); !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v, got %v", expected, keys)
+	}
+}
@@ -0,0 +1,70 @@
+package ds
+
+// Why interviewers ask this:
+// "Find the median from a data stream" is the standard two-heap problem. Sorting after
+// every insert is O(n log n) per query; the two-heap trick makes each insert O(log n)
+// and each median O(1), and tests whether you can keep two structures in balance.
+
+// Common pitfalls:
+// - Pushing into one heap without moving its top across, so the halves overlap
+// - Letting the size difference grow beyond one
+// - Integer division when averaging the two middle values
+// - Using two min-heaps (the lower half needs its largest element on top)
+
+// Key takeaway:
+// A max-heap holds the lower half and a min-heap the upper half, with every low value <=
+// every high value. Keep len(low) == len(high) or len(low) == len(high)+1. The median is
+// low's top for an odd count, or the average of both tops for an even count.
+
+// MedianFinder maintains the running median of a stream of ints
+// Time Complexity: Add O(log n), Median O(1)
+// Space Complexity: O(n) where n is the number of values added
+type MedianFinder struct {
+	low  *MaxHeap // Lower half, holds the extra element when the count is odd
+	high *MinHeap // Upper half
+}
+
+// NewMedianFinder creates a new empty median finder
+func NewMedianFinder() *MedianFinder {
+	return &MedianFinder{
+		low:  NewMaxHeap(),
+		high: NewMinHeap(),
+	}
+}
+
+// Add inserts a value into the stream
+// Time Complexity: O(log n)
+func (m *MedianFinder) Add(value int) {
+	// Route through low so its largest element is the one promoted to high
+	m.low.Insert(value)
+	top, _ := m.low.ExtractMax()
+	m.high.Insert(top)
+
+	// Rebalance so low is never smaller than high
+	if m.high.Size() > m.low.Size() {
+		smallest, _ := m.high.ExtractMin()
+		m.low.Insert(smallest)
+	}
+}
+
+// Median returns the median of all values added so far
+// Returns 0 and false if no values have been added
+// Time Complexity: O(1)
+func (m *MedianFinder) Median() (float64, bool) {
+	if m.low.IsEmpty() {
+		return 0, false
+	}
+
+	lowTop, _ := m.low.Peek()
+	if m.low.Size() > m.high.Size() {
+		return float64(lowTop), true
+	}
+
+	highTop, _ := m.high.Peek()
+	return (float64(lowTop) + float64(highTop)) / 2, true
+}
+
+// Size returns the number of values added
+func (m *MedianFinder) Size() int {
+	return m.low.Size() + m.high.Size()
+}
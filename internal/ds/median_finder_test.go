@@ -0,0 +1,82 @@
+package ds
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// sortedMedian computes the median of values the slow way
+func sortedMedian(values []int) float64 {
+	sorted := append([]int(nil), values...)
+	sort.Ints(sorted)
+
+	n := len(sorted)
+	if n%2 == 1 {
+		return float64(sorted[n/2])
+	}
+	return (float64(sorted[n/2-1]) + float64(sorted[n/2])) / 2
+}
+
+func TestMedianFinder_Streams(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+
+	ascending := make([]int, 20)
+	descending := make([]int, 20)
+	random := make([]int, 200)
+	for i := range ascending {
+		ascending[i] = i
+		descending[i] = 20 - i
+	}
+	for i := range random {
+		random[i] = rng.Intn(100) - 50
+	}
+
+	tests := []struct {
+		name   string
+		stream []int
+	}{
+		{"ascending", ascending},
+		{"descending", descending},
+		{"random", random},
+	}
+
+	for _, tt := range tests {
+		m := NewMedianFinder()
+		for i, v := range tt.stream {
+			m.Add(v)
+
+			expected := sortedMedian(tt.stream[:i+1])
+			median, ok := m.Median()
+			if !ok || median != expected {
+				t.Fatalf("%s: after %d values expected median %v, got %v", tt.name, i+1, expected, median)
+			}
+		}
+		if m.Size() != len(tt.stream) {
+			t.Errorf("%s: expected size %d, got %d", tt.name, len(tt.stream), m.Size())
+		}
+	}
+}
+
+func TestMedianFinder_EvenCountAverages(t *testing.T) {
+	m := NewMedianFinder()
+	m.Add(1)
+	m.Add(2)
+
+	if median, _ := m.Median(); median != 1.5 {
+		t.Errorf("expected 1.5, got %v", median)
+	}
+
+	m.Add(3)
+	if median, _ := m.Median(); median != 2 {
+		t.Errorf("expected 2, got %v", median)
+	}
+}
+
+func TestMedianFinder_Empty(t *testing.T) {
+	m := NewMedianFinder()
+
+	if _, ok := m.Median(); ok {
+		t.Error("median of empty stream should fail")
+	}
+}
@@ -0,0 +1,165 @@
+package ds
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Why interviewers ask this:
+// N-ary trees model file systems, org charts, DOM trees, and comment threads. They test
+// whether your binary-tree instincts generalize: traversals still work, but "left/right"
+// becomes "for each child", and serialization has to record how many children a node has.
+
+// Common pitfalls:
+// - Serializing values only, so the shape (child counts) cannot be recovered
+// - Treating a leaf (zero children) differently from an empty tree
+// - Counting depth in edges when the problem counts nodes (a single node has depth 1)
+// - Recursing on very deep trees where an explicit stack/queue is safer
+// - Trusting a parsed count to size an allocation before checking it against the input
+
+// Key takeaway:
+// Preorder with a child count per node ("value,count,...") is enough to rebuild the
+// tree: read a node, then read exactly count subtrees. Level-order BFS processes the
+// queue one level at a time, which also yields max depth for free.
+
+// NaryTreeNode represents a node in an N-ary tree
+type NaryTreeNode struct {
+	Value    int
+	Children []*NaryTreeNode
+}
+
+// NewNaryTreeNode creates a node with the given value and children
+func NewNaryTreeNode(value int, children ...*NaryTreeNode) *NaryTreeNode {
+	return &NaryTreeNode{Value: value, Children: children}
+}
+
+// MaxDepth returns the number of nodes on the longest root-to-leaf path
+// Depth of an empty tree is 0, a single node is 1
+// Time Complexity: O(n), Space Complexity: O(w) where w is max width
+func (n *NaryTreeNode) MaxDepth() int {
+	return len(n.LevelOrder())
+}
+
+// LevelOrder returns node values grouped by level (BFS)
+// Time Complexity: O(n), Space Complexity: O(w) where w is max width
+func (n *NaryTreeNode) LevelOrder() [][]int {
+	result := [][]int{}
+	if n == nil {
+		return result
+	}
+
+	queue := []*NaryTreeNode{n}
+
+	for len(queue) > 0 {
+		levelSize := len(queue)
+		level := make([]int, 0, levelSize)
+
+		for i := 0; i < levelSize; i++ {
+			current := queue[i]
+			level = append(level, current.Value)
+			queue = append(queue, current.Children...)
+		}
+
+		queue = queue[levelSize:]
+		result = append(result, level)
+	}
+
+	return result
+}
+
+// Serialize encodes the tree as comma-separated "value,childCount" pairs in preorder
+// Example: 1 with children [2 (child 4), 3] -> "1,2,2,1,4,0,3,0". Empty tree -> "".
+// Time Complexity: O(n)
+func (n *NaryTreeNode) Serialize() string {
+	if n == nil {
+		return ""
+	}
+
+	parts := []string{}
+	stack := []*NaryTreeNode{n}
+
+	// Explicit stack instead of recursion so deep trees cannot blow the call stack
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		parts = append(parts, strconv.Itoa(current.Value), strconv.Itoa(len(current.Children)))
+
+		// Push children in reverse so the first child is processed first
+		for i := len(current.Children) - 1; i >= 0; i-- {
+			stack = append(stack, current.Children[i])
+		}
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// DeserializeNaryTree rebuilds a tree produced by Serialize
+// Returns nil and no error for an empty string
+// Time Complexity: O(n)
+func DeserializeNaryTree(data string) (*NaryTreeNode, error) {
+	if data == "" {
+		return nil, nil
+	}
+
+	tokens := strings.Split(data, ",")
+	if len(tokens)%2 != 0 {
+		return nil, fmt.Errorf("nary tree: expected value,count pairs, got %d tokens", len(tokens))
+	}
+
+	// pending tracks nodes that are still waiting for children
+	type pending struct {
+		node      *NaryTreeNode
+		remaining int
+	}
+
+	var root *NaryTreeNode
+	stack := []*pending{}
+
+	for i := 0; i < len(tokens); i += 2 {
+		value, err := strconv.Atoi(tokens[i])
+		if err != nil {
+			return nil, fmt.Errorf("nary tree: invalid value %q: %w", tokens[i], err)
+		}
+		count, err := strconv.Atoi(tokens[i+1])
+		if err != nil || count < 0 {
+			return nil, fmt.Errorf("nary tree: invalid child count %q", tokens[i+1])
+		}
+
+		// Each child needs its own pair, so a count beyond the pairs left is malformed;
+		// checking before make also keeps a huge count from becoming a huge allocation
+		if left := (len(tokens)-i)/2 - 1; count > left {
+			return nil, fmt.Errorf("nary tree: child count %d exceeds the %d node(s) left", count, left)
+		}
+
+		node := &NaryTreeNode{Value: value}
+		if count > 0 {
+			node.Children = make([]*NaryTreeNode, 0, count)
+		}
+
+		if root == nil {
+			root = node
+		} else {
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("nary tree: unexpected node %d after tree was complete", value)
+			}
+			parent := stack[len(stack)-1]
+			parent.node.Children = append(parent.node.Children, node)
+			parent.remaining--
+			if parent.remaining == 0 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+
+		if count > 0 {
+			stack = append(stack, &pending{node: node, remaining: count})
+		}
+	}
+
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("nary tree: input ended with %d node(s) missing children", len(stack))
+	}
+
+	return root, nil
+}
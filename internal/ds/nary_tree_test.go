@@ -0,0 +1,125 @@
+package ds
+
+import (
+	"reflect"
+	"testing"
+)
+
+// buildSampleNaryTree builds:
+//
+//	      1
+//	   /  |  \
+//	  3   2   4
+//	 / \
+//	5   6
+func buildSampleNaryTree() *NaryTreeNode {
+	return NewNaryTreeNode(1,
+		NewNaryTreeNode(3, NewNaryTreeNode(5), NewNaryTreeNode(6)),
+		NewNaryTreeNode(2),
+		NewNaryTreeNode(4),
+	)
+}
+
+func TestNaryTree_MaxDepth(t *testing.T) {
+	root := buildSampleNaryTree()
+
+	if root.MaxDepth() != 3 {
+		t.Errorf("expected depth 3, got %d", root.MaxDepth())
+	}
+
+	if NewNaryTreeNode(7).MaxDepth() != 1 {
+		t.Error("single node should have depth 1")
+	}
+
+	var empty *NaryTreeNode
+	if empty.MaxDepth() != 0 {
+		t.Error("empty tree should have depth 0")
+	}
+}
+
+func TestNaryTree_LevelOrder(t *testing.T) {
+	root := buildSampleNaryTree()
+
+	expected := [][]int{{1}, {3, 2, 4}, {5, 6}}
+	if !reflect.DeepEqual(root.LevelOrder(), expected) {
+		t.Errorf("expected %v, got %v", expected, root.LevelOrder())
+	}
+}
+
+func TestNaryTree_SerializeRoundTrip(t *testing.T) {
+	root := buildSampleNaryTree()
+
+	data := root.Serialize()
+	if data != "1,3,3,2,5,0,6,0,2,0,4,0" {
+		t.Errorf("unexpected serialization: %s", data)
+	}
+
+	decoded, err := DeserializeNaryTree(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(decoded.LevelOrder(), root.LevelOrder()) {
+		t.Errorf("expected %v, got %v", root.LevelOrder(), decoded.LevelOrder())
+	}
+	if decoded.Serialize() != data {
+		t.Errorf("re-serialization differs: %s vs %s", decoded.Serialize(), data)
+	}
+}
+
+func TestNaryTree_SerializeEmpty(t *testing.T) {
+	var empty *NaryTreeNode
+	if empty.Serialize() != "" {
+		t.Error("empty tree should serialize to empty string")
+	}
+
+	decoded, err := DeserializeNaryTree("")
+	if err != nil || decoded != nil {
+		t.Errorf("expected nil tree and no error, got %v, %v", decoded, err)
+	}
+}
+
+func TestNaryTree_DeeplyNested(t *testing.T) {
+	// A 10,000-node chain: each node has exactly one child
+	depth := 10000
+	root := NewNaryTreeNode(0)
+	current := root
+	for i := 1; i < depth; i++ {
+		child := NewNaryTreeNode(i)
+		current.Children = []*NaryTreeNode{child}
+		current = child
+	}
+
+	if root.MaxDepth() != depth {
+		t.Errorf("expected depth %d, got %d", depth, root.MaxDepth())
+	}
+
+	decoded, err := DeserializeNaryTree(root.Serialize())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.MaxDepth() != depth {
+		t.Errorf("expected decoded depth %d, got %d", depth, decoded.MaxDepth())
+	}
+}
+
+func TestNaryTree_DeserializeInvalid(t *testing.T) {
+	inputs := []string{
+		"1",                      // Odd number of tokens
+		"x,0",                    // Bad value
+		"1,-1",                   // Negative count
+		"1,2,2,0",                // Missing a child
+		"1,0,2,0",                // Extra node after complete tree
+		"1,x",                    // Malformed count
+		"1,1.5",                  // Non-integer count
+		"1,99999999999999",       // Count far larger than the input
+		"1,99999999999999999999", // Count overflows int
+		"1,3,2,0,3,0",            // Count one more than the nodes left
+	}
+
+	for _, input := range inputs {
+		if _, err := DeserializeNaryTree(input); err == nil {
+			t.Errorf("DeserializeNaryTree(%q): expected error", input)
+		}
+	}
+}
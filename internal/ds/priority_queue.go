@@ -0,0 +1,169 @@
+package ds
+
+// Why interviewers ask this:
+// Dijkstra and Prim need "decrease key": an item already in the queue gets a better
+// priority. A plain heap can only find it with an O(n) scan, so interviewers ask how
+// to do it in O(log n). The answer - a map from item to heap index - is a classic
+// example of combining two data structures so each covers the other's weakness.
+
+// Common pitfalls:
+// - Forgetting to update the index map on every swap (the map silently goes stale)
+// - Only sifting up after an update; a worse priority must sift down instead
+// - Pushing duplicates instead of updating, then processing stale entries later
+// - Not deleting the popped item from the map, so UpdatePriority "finds" it
+
+// Key takeaway:
+// Keep entries in an array-backed min-heap and an index map item -> position. Every
+// swap updates both. UpdatePriority looks the item up in O(1), changes its priority,
+// then sifts up or down from that position - O(log n) instead of O(n).
+
+// pqEntry is an item with its priority
+type pqEntry struct {
+	item     string
+	priority int
+}
+
+// PriorityQueue is a min-priority queue of unique string items
+// The item with the lowest priority value is popped first
+// Time Complexity: Push/Pop/UpdatePriority O(log n), Peek/Contains O(1)
+// Space Complexity: O(n) where n is the number of items
+type PriorityQueue struct {
+	entries []pqEntry
+	index   map[string]int // item -> position in entries
+}
+
+// NewPriorityQueue creates a new empty priority queue
+func NewPriorityQueue() *PriorityQueue {
+	return &PriorityQueue{
+		entries: make([]pqEntry, 0),
+		index:   make(map[string]int),
+	}
+}
+
+// Push adds item with the given priority
+// If item is already queued, its priority is updated instead
+// Time Complexity: O(log n)
+func (pq *PriorityQueue) Push(item string, priority int) {
+	if pq.UpdatePriority(item, priority) {
+		return
+	}
+
+	pq.entries = append(pq.entries, pqEntry{item: item, priority: priority})
+	pq.index[item] = len(pq.entries) - 1
+	pq.heapifyUp(len(pq.entries) - 1)
+}
+
+// Pop removes and returns the item with the lowest priority
+// Returns "", 0 and false if the queue is empty
+// Time Complexity: O(log n)
+func (pq *PriorityQueue) Pop() (string, int, bool) {
+	if pq.IsEmpty() {
+		return "", 0, false
+	}
+
+	top := pq.entries[0]
+	lastIdx := len(pq.entries) - 1
+
+	pq.swap(0, lastIdx)
+	pq.entries = pq.entries[:lastIdx]
+	delete(pq.index, top.item)
+
+	if len(pq.entries) > 0 {
+		pq.heapifyDown(0)
+	}
+
+	return top.item, top.priority, true
+}
+
+// Peek returns the item with the lowest priority without removing it
+// Time Complexity: O(1)
+func (pq *PriorityQueue) Peek() (string, int, bool) {
+	if pq.IsEmpty() {
+		return "", 0, false
+	}
+
+	return pq.entries[0].item, pq.entries[0].priority, true
+}
+
+// UpdatePriority changes the priority of a queued item
+// Returns false if item is not in the queue
+// Time Complexity: O(log n)
+func (pq *PriorityQueue) UpdatePriority(item string, newPriority int) bool {
+	i, ok := pq.index[item]
+	if !ok {
+		return false
+	}
+
+	old := pq.entries[i].priority
+	pq.entries[i].priority = newPriority
+
+	// A lower value can only move toward the root; a higher one only away from it
+	if newPriority < old {
+		pq.heapifyUp(i)
+	} else {
+		pq.heapifyDown(i)
+	}
+
+	return true
+}
+
+// Contains reports whether item is queued
+func (pq *PriorityQueue) Contains(item string) bool {
+	_, ok := pq.index[item]
+	return ok
+}
+
+// swap exchanges two entries and keeps the index map in sync
+func (pq *PriorityQueue) swap(i, j int) {
+	pq.entries[i], pq.entries[j] = pq.entries[j], pq.entries[i]
+	pq.index[pq.entries[i].item] = i
+	pq.index[pq.entries[j].item] = j
+}
+
+func (pq *PriorityQueue) heapifyUp(index int) {
+	for index > 0 {
+		parentIdx := (index - 1) / 2
+
+		if pq.entries[index].priority >= pq.entries[parentIdx].priority {
+			break
+		}
+
+		pq.swap(index, parentIdx)
+		index = parentIdx
+	}
+}
+
+func (pq *PriorityQueue) heapifyDown(index int) {
+	size := len(pq.entries)
+
+	for {
+		smallest := index
+		leftChild := 2*index + 1
+		rightChild := 2*index + 2
+
+		if leftChild < size && pq.entries[leftChild].priority < pq.entries[smallest].priority {
+			smallest = leftChild
+		}
+
+		if rightChild < size && pq.entries[rightChild].priority < pq.entries[smallest].priority {
+			smallest = rightChild
+		}
+
+		if smallest == index {
+			break
+		}
+
+		pq.swap(index, smallest)
+		index = smallest
+	}
+}
+
+// IsEmpty returns true if the queue has no items
+func (pq *PriorityQueue) IsEmpty() bool {
+	return len(pq.entries) == 0
+}
+
+// Size returns the number of queued items
+func (pq *PriorityQueue) Size() int {
+	return len(pq.entries)
+}
@@ -0,0 +1,113 @@
+package ds
+
+import (
+	"reflect"
+	"testing"
+)
+
+// drain pops every item and returns them in pop order
+func drain(pq *PriorityQueue) []string {
+	var order []string
+	for !pq.IsEmpty() {
+		item, _, _ := pq.Pop()
+		order = append(order, item)
+	}
+	return order
+}
+
+func TestPriorityQueue_PushPop(t *testing.T) {
+	pq := NewPriorityQueue()
+	pq.Push("c", 30)
+	pq.Push("a", 10)
+	pq.Push("d", 40)
+	pq.Push("b", 20)
+
+	if item, priority, ok := pq.Peek(); !ok || item != "a" || priority != 10 {
+		t.Errorf("expected peek (a, 10), got (%s, %d)", item, priority)
+	}
+
+	item, priority, ok := pq.Pop()
+	if !ok || item != "a" || priority != 10 {
+		t.Errorf("expected pop (a, 10), got (%s, %d)", item, priority)
+	}
+	if pq.Contains("a") {
+		t.Error("popped item should no longer be queued")
+	}
+
+	expected := []string{"b", "c", "d"}
+	if order := drain(pq); !reflect.DeepEqual(order, expected) {
+		t.Errorf("expected %v, got %v", expected, order)
+	}
+
+	if _, _, ok := pq.Pop(); ok {
+		t.Error("pop from empty queue should fail")
+	}
+	if _, _, ok := pq.Peek(); ok {
+		t.Error("peek on empty queue should fail")
+	}
+}
+
+func TestPriorityQueue_DecreasePriority(t *testing.T) {
+	pq := NewPriorityQueue()
+	for i, item := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		pq.Push(item, (i+1)*10)
+	}
+
+	// g sits at the bottom of the heap; decreasing it must sift it to the root
+	if !pq.UpdatePriority("g", 5) {
+		t.Fatal("update of queued item should succeed")
+	}
+	if item, priority, _ := pq.Peek(); item != "g" || priority != 5 {
+		t.Errorf("expected peek (g, 5), got (%s, %d)", item, priority)
+	}
+
+	// d moves ahead of b and c but stays behind a
+	pq.UpdatePriority("d", 15)
+
+	expected := []string{"g", "a", "d", "b", "c", "e", "f"}
+	if order := drain(pq); !reflect.DeepEqual(order, expected) {
+		t.Errorf("expected %v, got %v", expected, order)
+	}
+}
+
+func TestPriorityQueue_IncreasePriority(t *testing.T) {
+	pq := NewPriorityQueue()
+	pq.Push("a", 1)
+	pq.Push("b", 2)
+	pq.Push("c", 3)
+
+	pq.UpdatePriority("a", 100)
+
+	expected := []string{"b", "c", "a"}
+	if order := drain(pq); !reflect.DeepEqual(order, expected) {
+		t.Errorf("expected %v, got %v", expected, order)
+	}
+}
+
+func TestPriorityQueue_UpdateMissing(t *testing.T) {
+	pq := NewPriorityQueue()
+	pq.Push("a", 1)
+
+	if pq.UpdatePriority("missing", 0) {
+		t.Error("update of missing item should return false")
+	}
+
+	pq.Pop()
+	if pq.UpdatePriority("a", 0) {
+		t.Error("update of popped item should return false")
+	}
+}
+
+func TestPriorityQueue_PushExistingUpdates(t *testing.T) {
+	pq := NewPriorityQueue()
+	pq.Push("a", 10)
+	pq.Push("b", 20)
+	pq.Push("b", 5)
+
+	if pq.Size() != 2 {
+		t.Errorf("expected size 2, got %d", pq.Size())
+	}
+	if item, priority, _ := pq.Peek(); item != "b" || priority != 5 {
+		t.Errorf("expected peek (b, 5), got (%s, %d)", item, priority)
+	}
+}
@@ -87,6 +87,7 @@ type CircularQueue struct {
 	rear     int
 	size     int
 	capacity int
+	growable bool // Double the buffer instead of rejecting Enqueue when full
 }
 
 // NewCircularQueue creates a circular queue with given capacity
@@ -100,12 +101,27 @@ func NewCircularQueue(capacity int) *CircularQueue {
 	}
 }
 
+// NewGrowingCircularQueue creates a circular queue that doubles its capacity when full,
+// so Enqueue never fails. Use NewCircularQueue when the queue must stay bounded
+func NewGrowingCircularQueue(initialCap int) *CircularQueue {
+	if initialCap < 1 {
+		initialCap = 1
+	}
+
+	q := NewCircularQueue(initialCap)
+	q.growable = true
+	return q
+}
+
 // Enqueue adds an element to the circular queue
-// Returns false if queue is full
-// Time Complexity: O(1)
+// Returns false if queue is full (never for a growing queue)
+// Time Complexity: O(1), amortized for a growing queue
 func (q *CircularQueue) Enqueue(item interface{}) bool {
 	if q.IsFull() {
-		return false
+		if !q.growable {
+			return false
+		}
+		q.grow()
 	}
 
 	q.rear = (q.rear + 1) % q.capacity
@@ -155,3 +171,19 @@ func (q *CircularQueue) IsFull() bool {
 func (q *CircularQueue) Size() int {
 	return q.size
 }
+
+// grow doubles the capacity and re-linearizes the elements
+// The live elements may wrap past the end of the buffer, so they are copied in
+// logical order starting at index 0 rather than with a single copy()
+// Time Complexity: O(n)
+func (q *CircularQueue) grow() {
+	grown := make([]interface{}, q.capacity*2)
+	for i := 0; i < q.size; i++ {
+		grown[i] = q.items[(q.front+i)%q.capacity]
+	}
+
+	q.items = grown
+	q.capacity *= 2
+	q.front = 0
+	q.rear = q.size - 1
+}
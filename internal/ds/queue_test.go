@@ -1,6 +1,9 @@
 package ds
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestQueue_EnqueueAndDequeue(t *testing.T) {
 	q := NewQueue()
@@ -287,3 +290,76 @@ func TestQueue_SingleElement(t *testing.T) {
 		t.Error("queue should be empty after dequeuing single element")
 	}
 }
+
+func TestCircularQueue_GrowingNeverFails(t *testing.T) {
+	q := NewGrowingCircularQueue(2)
+
+	for i := 0; i < 10; i++ {
+		if !q.Enqueue(i) {
+			t.Fatalf("enqueue %d should not fail on a growing queue", i)
+		}
+	}
+	if q.Size() != 10 || q.capacity < 10 {
+		t.Errorf("expected size 10 and capacity >= 10, got %d and %d", q.Size(), q.capacity)
+	}
+
+	var result []interface{}
+	for !q.IsEmpty() {
+		v, _ := q.Dequeue()
+		result = append(result, v)
+	}
+
+	expected := []interface{}{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestCircularQueue_GrowWhileWrapped(t *testing.T) {
+	q := NewGrowingCircularQueue(4)
+
+	// Advance front so the live elements wrap: buffer is [e f c d], front at index 2
+	q.Enqueue("a")
+	q.Enqueue("b")
+	q.Enqueue("c")
+	q.Enqueue("d")
+	q.Dequeue()
+	q.Dequeue()
+	q.Enqueue("e")
+	q.Enqueue("f")
+	if q.front != 2 || q.capacity != 4 {
+		t.Fatalf("expected wrapped full buffer at capacity 4, front %d capacity %d", q.front, q.capacity)
+	}
+
+	q.Enqueue("g") // Grow: must unwrap to [c d e f g]
+
+	expectedItems := []interface{}{"c", "d", "e", "f", "g", nil, nil, nil}
+	if !reflect.DeepEqual(q.items, expectedItems) {
+		t.Errorf("expected re-linearized buffer %v, got %v", expectedItems, q.items)
+	}
+
+	if front, _ := q.Peek(); front != "c" {
+		t.Errorf("expected front c, got %v", front)
+	}
+
+	var result []interface{}
+	for !q.IsEmpty() {
+		v, _ := q.Dequeue()
+		result = append(result, v)
+	}
+
+	expected := []interface{}{"c", "d", "e", "f", "g"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestCircularQueue_FixedStillBounded(t *testing.T) {
+	q := NewCircularQueue(2)
+	q.Enqueue(1)
+	q.Enqueue(2)
+
+	if q.Enqueue(3) {
+		t.Error("fixed-size queue should reject enqueue when full")
+	}
+}
@@ -0,0 +1,98 @@
+package ds
+
+import "math/rand"
+
+// Why interviewers ask this:
+// "Insert, Delete, GetRandom in O(1)" is a design question with no single built-in answer:
+// maps give O(1) insert/delete but no random access, slices give random access but O(n)
+// delete. Interviewers want to see you combine them and spot the swap-with-last trick.
+
+// Common pitfalls:
+// - Deleting from the middle of the slice (O(n) shift) instead of swapping with the last
+// - Forgetting to update the moved element's index in the map after the swap
+// - Removing the map entry before reading the index it held
+// - Hard-coding a global random source, making GetRandom impossible to test
+
+// Key takeaway:
+// Keep values in a slice for O(1) random access and a map value -> index for O(1) lookup.
+// To remove, overwrite the victim with the last element, fix that element's index, and
+// shrink the slice by one. Inject the random source so tests are deterministic.
+
+// RandomizedSet is a set of ints supporting O(1) insert, remove and uniform random access
+// Time Complexity: Insert O(1) average, Remove O(1) average, GetRandom O(1)
+// Space Complexity: O(n)
+type RandomizedSet struct {
+	values  []int
+	indexes map[int]int // value -> position in values
+	rng     *rand.Rand
+}
+
+// NewRandomizedSet creates an empty set with a randomly seeded source
+func NewRandomizedSet() *RandomizedSet {
+	return NewRandomizedSetWithRand(rand.New(rand.NewSource(rand.Int63())))
+}
+
+// NewRandomizedSetWithRand creates an empty set that draws from rng
+// Pass a seeded *rand.Rand to make GetRandom deterministic in tests
+func NewRandomizedSetWithRand(rng *rand.Rand) *RandomizedSet {
+	return &RandomizedSet{
+		values:  make([]int, 0),
+		indexes: make(map[int]int),
+		rng:     rng,
+	}
+}
+
+// Insert adds val to the set
+// Returns false if val was already present
+// Time Complexity: O(1) average
+func (rs *RandomizedSet) Insert(val int) bool {
+	if _, exists := rs.indexes[val]; exists {
+		return false
+	}
+
+	rs.indexes[val] = len(rs.values)
+	rs.values = append(rs.values, val)
+	return true
+}
+
+// Remove deletes val from the set
+// Returns false if val was not present
+// Time Complexity: O(1) average
+func (rs *RandomizedSet) Remove(val int) bool {
+	idx, exists := rs.indexes[val]
+	if !exists {
+		return false
+	}
+
+	// Move the last element into the hole, then drop the last slot
+	lastIdx := len(rs.values) - 1
+	last := rs.values[lastIdx]
+	rs.values[idx] = last
+	rs.indexes[last] = idx
+
+	rs.values = rs.values[:lastIdx]
+	delete(rs.indexes, val)
+	return true
+}
+
+// GetRandom returns a uniformly random element
+// Returns 0 and false if the set is empty
+// Time Complexity: O(1)
+func (rs *RandomizedSet) GetRandom() (int, bool) {
+	if len(rs.values) == 0 {
+		return 0, false
+	}
+
+	return rs.values[rs.rng.Intn(len(rs.values))], true
+}
+
+// Contains reports whether val is in the set
+func (rs *RandomizedSet) Contains(val int) bool {
+	_, exists := rs.indexes[val]
+	return exists
+}
+
+// Size returns the number of elements
+func (rs *RandomizedSet) Size() int {
+	return len(rs.values)
+}
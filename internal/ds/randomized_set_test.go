@@ -0,0 +1,107 @@
+package ds
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRandomizedSet_InsertRemove(t *testing.T) {
+	rs := NewRandomizedSet()
+
+	if !rs.Insert(1) {
+		t.Error("insert of new value should succeed")
+	}
+	if rs.Insert(1) {
+		t.Error("insert of duplicate should fail")
+	}
+	rs.Insert(2)
+	rs.Insert(3)
+
+	if rs.Size() != 3 {
+		t.Errorf("expected size 3, got %d", rs.Size())
+	}
+
+	if !rs.Remove(1) {
+		t.Error("remove of present value should succeed")
+	}
+	if rs.Remove(1) {
+		t.Error("remove of absent value should fail")
+	}
+
+	if rs.Contains(1) || !rs.Contains(2) || !rs.Contains(3) {
+		t.Error("unexpected contents after remove")
+	}
+	if rs.Size() != 2 {
+		t.Errorf("expected size 2, got %d", rs.Size())
+	}
+}
+
+func TestRandomizedSet_RemoveKeepsIndexesConsistent(t *testing.T) {
+	rs := NewRandomizedSet()
+	for i := 0; i < 10; i++ {
+		rs.Insert(i)
+	}
+
+	// Remove from the middle, the front and the back
+	for _, v := range []int{5, 0, 9} {
+		rs.Remove(v)
+	}
+
+	for v, idx := range rs.indexes {
+		if rs.values[idx] != v {
+			t.Errorf("index for %d points at %d", v, rs.values[idx])
+		}
+	}
+	if len(rs.indexes) != len(rs.values) {
+		t.Errorf("map and slice out of sync: %d vs %d", len(rs.indexes), len(rs.values))
+	}
+}
+
+func TestRandomizedSet_GetRandomOnlyReturnsPresent(t *testing.T) {
+	rs := NewRandomizedSetWithRand(rand.New(rand.NewSource(42)))
+	for _, v := range []int{10, 20, 30, 40} {
+		rs.Insert(v)
+	}
+	rs.Remove(20)
+
+	seen := make(map[int]bool)
+	for i := 0; i < 200; i++ {
+		v, ok := rs.GetRandom()
+		if !ok {
+			t.Fatal("GetRandom should succeed on non-empty set")
+		}
+		if !rs.Contains(v) {
+			t.Fatalf("GetRandom returned %d which is not in the set", v)
+		}
+		seen[v] = true
+	}
+
+	if len(seen) != 3 {
+		t.Errorf("expected all 3 values to be returned eventually, saw %v", seen)
+	}
+}
+
+func TestRandomizedSet_GetRandomDeterministicWithSeed(t *testing.T) {
+	a := NewRandomizedSetWithRand(rand.New(rand.NewSource(7)))
+	b := NewRandomizedSetWithRand(rand.New(rand.NewSource(7)))
+	for i := 0; i < 5; i++ {
+		a.Insert(i)
+		b.Insert(i)
+	}
+
+	for i := 0; i < 20; i++ {
+		va, _ := a.GetRandom()
+		vb, _ := b.GetRandom()
+		if va != vb {
+			t.Fatal("same seed should produce the same sequence")
+		}
+	}
+}
+
+func TestRandomizedSet_GetRandomEmpty(t *testing.T) {
+	rs := NewRandomizedSet()
+
+	if _, ok := rs.GetRandom(); ok {
+		t.Error("GetRandom on empty set should return false")
+	}
+}
@@ -0,0 +1,162 @@
+package ds
+
+// Why interviewers ask this:
+// Real caches (HTTP caches, image caches, groupcache) are bounded by memory, not by item
+// count: one 5 MB response should cost as much as five thousand 1 KB ones. It's a natural
+// follow-up to the classic LRU that checks whether you can generalize the eviction rule.
+
+// Common pitfalls:
+// - Evicting only once per Put (a large item may need several small ones evicted)
+// - Accepting an item bigger than the whole budget, which then evicts everything including itself
+// - Forgetting to adjust the used total when an existing key is updated with a new size
+// - Letting sizes go negative or zero so the budget is never enforced
+
+// Key takeaway:
+// Same structure as LRU (hash map + doubly linked list), but track a running byte total.
+// After each Put, evict from the tail in a loop until total <= budget. Reject items whose
+// cost alone exceeds the budget before touching the cache.
+
+// sizedLRUNode represents a node in the doubly linked list
+type sizedLRUNode[K comparable, V any] struct {
+	key   K
+	value V
+	size  int
+	prev  *sizedLRUNode[K, V]
+	next  *sizedLRUNode[K, V]
+}
+
+// SizedLRU is a Least Recently Used cache bounded by a total size budget
+// Time Complexity: Get O(1), Put O(1) amortized (each item is evicted at most once)
+// Space Complexity: O(n)
+type SizedLRU[K comparable, V any] struct {
+	budget int
+	used   int
+	cache  map[K]*sizedLRUNode[K, V]
+	head   *sizedLRUNode[K, V] // Most recently used
+	tail   *sizedLRUNode[K, V] // Least recently used
+}
+
+// NewSizedLRU creates a cache whose item sizes may sum to at most budget
+func NewSizedLRU[K comparable, V any](budget int) *SizedLRU[K, V] {
+	if budget < 1 {
+		budget = 1
+	}
+
+	head := &sizedLRUNode[K, V]{}
+	tail := &sizedLRUNode[K, V]{}
+	head.next = tail
+	tail.prev = head
+
+	return &SizedLRU[K, V]{
+		budget: budget,
+		cache:  make(map[K]*sizedLRUNode[K, V]),
+		head:   head,
+		tail:   tail,
+	}
+}
+
+// Get retrieves a value from the cache and marks it most recently used
+// Returns the zero value and false if key doesn't exist
+// Time Complexity: O(1)
+func (c *SizedLRU[K, V]) Get(key K) (V, bool) {
+	node, exists := c.cache[key]
+	if !exists {
+		var zero V
+		return zero, false
+	}
+
+	c.moveToFront(node)
+	return node.value, true
+}
+
+// Put adds or updates key with the given size cost, then evicts least recently
+// used items until the total fits the budget
+// Returns false, leaving the cache unchanged, if size is not positive or exceeds the budget
+// Time Complexity: O(1) amortized
+func (c *SizedLRU[K, V]) Put(key K, value V, size int) bool {
+	if size <= 0 || size > c.budget {
+		return false
+	}
+
+	if node, exists := c.cache[key]; exists {
+		c.used += size - node.size
+		node.value = value
+		node.size = size
+		c.moveToFront(node)
+	} else {
+		node := &sizedLRUNode[K, V]{key: key, value: value, size: size}
+		c.cache[key] = node
+		c.addToFront(node)
+		c.used += size
+	}
+
+	// The new item fits on its own, so this stops before reaching it
+	for c.used > c.budget {
+		c.evictLRU()
+	}
+
+	return true
+}
+
+// Delete removes a key from the cache
+// Returns true if key was found and deleted
+// Time Complexity: O(1)
+func (c *SizedLRU[K, V]) Delete(key K) bool {
+	node, exists := c.cache[key]
+	if !exists {
+		return false
+	}
+
+	c.removeNode(node)
+	delete(c.cache, key)
+	c.used -= node.size
+
+	return true
+}
+
+// Len returns the number of items in the cache
+func (c *SizedLRU[K, V]) Len() int {
+	return len(c.cache)
+}
+
+// Used returns the total size of all items in the cache
+func (c *SizedLRU[K, V]) Used() int {
+	return c.used
+}
+
+// Budget returns the maximum total size of the cache
+func (c *SizedLRU[K, V]) Budget() int {
+	return c.budget
+}
+
+// moveToFront moves a node to the front of the list (most recently used)
+func (c *SizedLRU[K, V]) moveToFront(node *sizedLRUNode[K, V]) {
+	c.removeNode(node)
+	c.addToFront(node)
+}
+
+// addToFront adds a node to the front of the list
+func (c *SizedLRU[K, V]) addToFront(node *sizedLRUNode[K, V]) {
+	node.next = c.head.next
+	node.prev = c.head
+	c.head.next.prev = node
+	c.head.next = node
+}
+
+// removeNode removes a node from the list
+func (c *SizedLRU[K, V]) removeNode(node *sizedLRUNode[K, V]) {
+	node.prev.next = node.next
+	node.next.prev = node.prev
+}
+
+// evictLRU removes the least recently used item (tail)
+func (c *SizedLRU[K, V]) evictLRU() {
+	node := c.tail.prev
+	if node == c.head {
+		return // Empty list
+	}
+
+	c.removeNode(node)
+	delete(c.cache, node.key)
+	c.used -= node.size
+}
@@ -0,0 +1,114 @@
+package ds
+
+import "testing"
+
+func TestSizedLRU_PutAndGet(t *testing.T) {
+	cache := NewSizedLRU[string, []byte](100)
+
+	cache.Put("a", []byte("alpha"), 10)
+	cache.Put("b", []byte("beta"), 20)
+
+	val, ok := cache.Get("a")
+	if !ok || string(val) != "alpha" {
+		t.Errorf("expected alpha, got %s", val)
+	}
+	if cache.Used() != 30 || cache.Len() != 2 {
+		t.Errorf("expected used 30 with 2 items, got used %d with %d items", cache.Used(), cache.Len())
+	}
+}
+
+func TestSizedLRU_LargeItemEvictsSeveralSmall(t *testing.T) {
+	cache := NewSizedLRU[string, int](100)
+
+	for i, key := range []string{"a", "b", "c", "d", "e"} {
+		cache.Put(key, i, 20)
+	}
+	cache.Get("a") // "a" becomes most recently used; "b" is now oldest
+
+	// 100 used; adding 50 must evict "b", "c" and "d" (oldest first)
+	if !cache.Put("big", 99, 50) {
+		t.Fatal("expected item within budget to be accepted")
+	}
+
+	for _, key := range []string{"b", "c", "d"} {
+		if _, ok := cache.Get(key); ok {
+			t.Errorf("expected %s to be evicted", key)
+		}
+	}
+	for _, key := range []string{"a", "e", "big"} {
+		if _, ok := cache.Get(key); !ok {
+			t.Errorf("expected %s to remain", key)
+		}
+	}
+	if cache.Used() != 90 {
+		t.Errorf("expected used 90, got %d", cache.Used())
+	}
+}
+
+func TestSizedLRU_RejectsItemLargerThanBudget(t *testing.T) {
+	cache := NewSizedLRU[string, int](100)
+	cache.Put("a", 1, 40)
+
+	if cache.Put("huge", 2, 101) {
+		t.Error("expected item larger than budget to be rejected")
+	}
+	if _, ok := cache.Get("huge"); ok {
+		t.Error("rejected item should not be cached")
+	}
+	if _, ok := cache.Get("a"); !ok || cache.Used() != 40 {
+		t.Errorf("rejection should leave cache unchanged, used %d", cache.Used())
+	}
+}
+
+func TestSizedLRU_RejectsNonPositiveSize(t *testing.T) {
+	cache := NewSizedLRU[string, int](100)
+	cache.Put("a", 1, 40)
+
+	if cache.Put("free", 2, 0) {
+		t.Error("expected zero-size item to be rejected")
+	}
+	if cache.Put("negative", 3, -10) {
+		t.Error("expected negative-size item to be rejected")
+	}
+	if cache.Put("a", 4, 0) {
+		t.Error("expected zero-size update to be rejected")
+	}
+
+	if cache.Len() != 1 || cache.Used() != 40 {
+		t.Errorf("rejections should leave cache unchanged, len %d used %d", cache.Len(), cache.Used())
+	}
+	if val, ok := cache.Get("a"); !ok || val != 1 {
+		t.Errorf("expected original value 1, got %v", val)
+	}
+}
+
+func TestSizedLRU_UpdateAdjustsUsedSize(t *testing.T) {
+	cache := NewSizedLRU[string, int](100)
+	cache.Put("a", 1, 30)
+	cache.Put("b", 2, 30)
+
+	// Growing "a" to 80 pushes the total to 110, so "b" (least recent) goes
+	cache.Put("a", 10, 80)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	if val, _ := cache.Get("a"); val != 10 || cache.Used() != 80 {
+		t.Errorf("expected a=10 with used 80, got a=%d with used %d", val, cache.Used())
+	}
+}
+
+func TestSizedLRU_Delete(t *testing.T) {
+	cache := NewSizedLRU[int, string](10)
+	cache.Put(1, "one", 4)
+
+	if !cache.Delete(1) {
+		t.Error("delete should succeed for existing key")
+	}
+	if cache.Delete(1) {
+		t.Error("delete should fail for missing key")
+	}
+	if cache.Used() != 0 || cache.Len() != 0 {
+		t.Errorf("expected empty cache, got used %d with %d items", cache.Used(), cache.Len())
+	}
+}
@@ -79,6 +79,14 @@ func (s *Stack) Clear() {
 	s.items = make([]interface{}, 0)
 }
 
+// ToSlice returns a copy of the elements from bottom to top
+// Time Complexity: O(n)
+func (s *Stack) ToSlice() []interface{} {
+	result := make([]interface{}, len(s.items))
+	copy(result, s.items)
+	return result
+}
+
 // IntStack is a type-safe stack for integers
 // This demonstrates how to create specialized stacks without using interface{}
 type IntStack struct {
@@ -130,3 +138,71 @@ func (s *IntStack) IsEmpty() bool {
 func (s *IntStack) Size() int {
 	return len(s.items)
 }
+
+// MinStack is an integer stack that also reports its minimum in O(1)
+// A parallel stack records the minimum at each depth, so popping restores the old minimum
+// Time Complexity: Push, Pop, Top, Min all O(1)
+// Space Complexity: O(n) - two entries per element
+type MinStack struct {
+	items []int
+	mins  []int // mins[i] is the minimum of items[0..i]
+}
+
+// NewMinStack creates a new empty min stack
+func NewMinStack() *MinStack {
+	return &MinStack{
+		items: make([]int, 0),
+		mins:  make([]int, 0),
+	}
+}
+
+// Push adds an integer to the stack
+func (s *MinStack) Push(item int) {
+	currentMin := item
+	if len(s.mins) > 0 && s.mins[len(s.mins)-1] < item {
+		currentMin = s.mins[len(s.mins)-1]
+	}
+
+	s.items = append(s.items, item)
+	s.mins = append(s.mins, currentMin)
+}
+
+// Pop removes and returns the top integer
+// Returns 0 and false if stack is empty
+func (s *MinStack) Pop() (int, bool) {
+	if len(s.items) == 0 {
+		return 0, false
+	}
+
+	index := len(s.items) - 1
+	item := s.items[index]
+	s.items = s.items[:index]
+	s.mins = s.mins[:index]
+
+	return item, true
+}
+
+// Top returns the top integer without removing it
+// Returns 0 and false if stack is empty
+func (s *MinStack) Top() (int, bool) {
+	if len(s.items) == 0 {
+		return 0, false
+	}
+
+	return s.items[len(s.items)-1], true
+}
+
+// Min returns the smallest integer currently in the stack
+// Returns 0 and false if stack is empty
+func (s *MinStack) Min() (int, bool) {
+	if len(s.mins) == 0 {
+		return 0, false
+	}
+
+	return s.mins[len(s.mins)-1], true
+}
+
+// Size returns the number of elements
+func (s *MinStack) Size() int {
+	return len(s.items)
+}
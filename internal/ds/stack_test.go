@@ -1,6 +1,9 @@
 package ds
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestStack_PushAndPop(t *testing.T) {
 	s := NewStack()
@@ -250,3 +253,100 @@ func TestStack_SingleElement(t *testing.T) {
 		t.Error("stack should be empty after popping single element")
 	}
 }
+
+func TestMinStack_MinTracksPops(t *testing.T) {
+	s := NewMinStack()
+
+	pushes := []struct {
+		value   int
+		wantMin int
+	}{
+		{5, 5},
+		{3, 3},
+		{7, 3},
+		{3, 3}, // Duplicate minimum
+		{1, 1},
+		{4, 1},
+	}
+	for _, p := range pushes {
+		s.Push(p.value)
+		if min, ok := s.Min(); !ok || min != p.wantMin {
+			t.Errorf("after Push(%d): expected min %d, got %d", p.value, p.wantMin, min)
+		}
+	}
+
+	// Pop back down; the minimum must be restored as its holders leave
+	pops := []struct {
+		popped  int
+		wantMin int
+	}{
+		{4, 1},
+		{1, 3}, // Element holding the min is gone
+		{3, 3}, // The other 3 is still there
+		{7, 3},
+		{3, 5},
+	}
+	for _, p := range pops {
+		val, ok := s.Pop()
+		if !ok || val != p.popped {
+			t.Fatalf("expected pop %d, got %d", p.popped, val)
+		}
+		if min, _ := s.Min(); min != p.wantMin {
+			t.Errorf("after popping %d: expected min %d, got %d", p.popped, p.wantMin, min)
+		}
+	}
+
+	if top, ok := s.Top(); !ok || top != 5 {
+		t.Errorf("expected top 5, got %d", top)
+	}
+	if s.Size() != 1 {
+		t.Errorf("expected size 1, got %d", s.Size())
+	}
+}
+
+func TestMinStack_Empty(t *testing.T) {
+	s := NewMinStack()
+
+	if _, ok := s.Pop(); ok {
+		t.Error("pop from empty stack should fail")
+	}
+	if _, ok := s.Top(); ok {
+		t.Error("top of empty stack should fail")
+	}
+	if _, ok := s.Min(); ok {
+		t.Error("min of empty stack should fail")
+	}
+
+	s.Push(-2)
+	s.Pop()
+	if _, ok := s.Min(); ok {
+		t.Error("min should fail once the stack is empty again")
+	}
+}
+
+func TestStack_ToSlice(t *testing.T) {
+	stack := NewStack()
+	if len(stack.ToSlice()) != 0 {
+		t.Error("expected empty slice for empty stack")
+	}
+
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	stack.Pop()
+	stack.Push(4)
+
+	expected := []interface{}{1, 2, 4} // Bottom to top
+	snapshot := stack.ToSlice()
+	if !reflect.DeepEqual(snapshot, expected) {
+		t.Errorf("expected %v, got %v", expected, snapshot)
+	}
+
+	// Snapshot is a copy
+	snapshot[0] = 100
+	stack.Pop()
+	stack.Pop()
+	if stack.Peek() != 1 {
+		t.Errorf("mutating ToSlice result changed the stack, bottom is %v", stack.Peek())
+	}
+}
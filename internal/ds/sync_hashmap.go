@@ -0,0 +1,76 @@
+package ds
+
+import "sync"
+
+// Why interviewers ask this:
+// "Now make it thread-safe" is the standard follow-up to any data structure question.
+// Wrapping with a RWMutex is the simplest correct answer and sets up the discussion of
+// lock granularity, read-heavy workloads, and why Go ships sync.Map separately.
+
+// Common pitfalls:
+// - Taking the write lock for reads, serializing readers for no reason
+// - Returning internal slices or entries that callers can use after the lock is released
+// - Composing two locked calls (Contains then Put) and assuming the pair is atomic
+// - Copying the wrapper by value, which copies the mutex
+
+// Key takeaway:
+// Reads (Get, Contains, Size, Keys) share an RLock; writes (Put, Delete) take the full
+// Lock. Every method is safe on its own, but check-then-act sequences still need a single
+// method that holds the lock across both steps. For hot maps, shard into several locks.
+
+// SyncHashMap is a HashMap safe for concurrent use
+// Time Complexity: Same as HashMap, plus lock acquisition
+// Space Complexity: O(n) where n is number of entries
+type SyncHashMap struct {
+	mu sync.RWMutex
+	hm *HashMap
+}
+
+// NewSyncHashMap creates a new thread-safe hash map with initial capacity
+func NewSyncHashMap(capacity int) *SyncHashMap {
+	return &SyncHashMap{hm: NewHashMap(capacity)}
+}
+
+// Get retrieves the value for a key
+// Returns nil and false if key doesn't exist
+func (m *SyncHashMap) Get(key string) (interface{}, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.hm.Get(key)
+}
+
+// Put inserts or updates a key-value pair
+func (m *SyncHashMap) Put(key string, value interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hm.Put(key, value)
+}
+
+// Delete removes a key-value pair
+// Returns true if key was found and deleted
+func (m *SyncHashMap) Delete(key string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.hm.Delete(key)
+}
+
+// Contains checks if a key exists
+func (m *SyncHashMap) Contains(key string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.hm.Contains(key)
+}
+
+// Size returns the number of key-value pairs
+func (m *SyncHashMap) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.hm.Size()
+}
+
+// Keys returns a snapshot of all keys; later writes don't affect it
+func (m *SyncHashMap) Keys() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.hm.Keys()
+}
@@ -0,0 +1,79 @@
+package ds
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestSyncHashMap_Basic(t *testing.T) {
+	m := NewSyncHashMap(4)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	if val, ok := m.Get("a"); !ok || val != 1 {
+		t.Errorf("expected 1, got %v", val)
+	}
+	if !m.Contains("b") || m.Contains("c") {
+		t.Error("contains returned wrong result")
+	}
+	if !m.Delete("a") || m.Delete("a") {
+		t.Error("delete should succeed once")
+	}
+	if m.Size() != 1 {
+		t.Errorf("expected size 1, got %d", m.Size())
+	}
+}
+
+// Run with: go test -race -run SyncHashMap ./internal/ds/
+func TestSyncHashMap_ConcurrentAccess(t *testing.T) {
+	m := NewSyncHashMap(2) // Small start so writers race through several resizes
+	workers, perWorker := 8, 200
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			prefix := strconv.Itoa(w) + "-"
+
+			for i := 0; i < perWorker; i++ {
+				m.Put(prefix+strconv.Itoa(i), i)
+				m.Get(strconv.Itoa((w+1)%workers) + "-" + strconv.Itoa(i)) // Read another worker's keys
+				m.Contains(prefix + strconv.Itoa(i/2))
+				if i%2 == 1 {
+					m.Delete(prefix + strconv.Itoa(i))
+				}
+			}
+			m.Keys()
+			m.Size()
+		}(w)
+	}
+	wg.Wait()
+
+	// Serial replay: each worker owns its keys, so the outcome is deterministic
+	replay := NewHashMap(2)
+	for w := 0; w < workers; w++ {
+		prefix := strconv.Itoa(w) + "-"
+		for i := 0; i < perWorker; i++ {
+			replay.Put(prefix+strconv.Itoa(i), i)
+			if i%2 == 1 {
+				replay.Delete(prefix + strconv.Itoa(i))
+			}
+		}
+	}
+
+	if m.Size() != replay.Size() {
+		t.Errorf("expected size %d, got %d", replay.Size(), m.Size())
+	}
+
+	keys, expected := m.Keys(), replay.Keys()
+	sort.Strings(keys)
+	sort.Strings(expected)
+	for i := range expected {
+		if keys[i] != expected[i] {
+			t.Fatalf("key sets differ at %d: expected %s, got %s", i, expected[i], keys[i])
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package ds
+
+import "sync"
+
+// Why interviewers ask this:
+// A shared work stack (e.g. parallel DFS) is a common place where a plain slice-backed
+// Stack breaks under concurrency. Wrapping it shows you know which operations must be
+// atomic - and that Pop must be a single locked call, not IsEmpty followed by Pop.
+
+// Common pitfalls:
+// - Checking IsEmpty and then calling Pop: another goroutine can empty it in between
+// - Looping until Pop returns nil: a pushed nil ends the loop with items still on the stack
+// - Returning the internal slice from a snapshot method instead of a copy
+// - Using an RWMutex when almost every operation writes (Push/Pop dominate)
+
+// Key takeaway:
+// Every method locks a single Mutex around the underlying Stack call. Pop's nil return
+// can't distinguish "empty" from a pushed nil, so drain with TryPop, which checks and pops
+// under one lock and reports emptiness with a separate bool.
+
+// SyncStack is a Stack safe for concurrent use
+// Time Complexity: Same as Stack, plus lock acquisition
+// Space Complexity: O(n) where n is the number of elements
+type SyncStack struct {
+	mu    sync.Mutex
+	stack *Stack
+}
+
+// NewSyncStack creates and returns a new empty thread-safe stack
+func NewSyncStack() *SyncStack {
+	return &SyncStack{stack: NewStack()}
+}
+
+// Push adds an element to the top of the stack
+func (s *SyncStack) Push(item interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Push(item)
+}
+
+// Pop removes and returns the top element
+// Returns nil if stack is empty; use TryPop if nil may have been pushed
+func (s *SyncStack) Pop() interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Pop()
+}
+
+// TryPop removes and returns the top element
+// Returns nil and false if stack is empty; a pushed nil comes back as nil and true
+func (s *SyncStack) TryPop() (interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stack.IsEmpty() {
+		return nil, false
+	}
+	return s.stack.Pop(), true
+}
+
+// Peek returns the top element without removing it
+// Returns nil if stack is empty
+func (s *SyncStack) Peek() interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Peek()
+}
+
+// Size returns the number of elements in the stack
+func (s *SyncStack) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Size()
+}
+
+// IsEmpty returns true if the stack has no elements
+func (s *SyncStack) IsEmpty() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.IsEmpty()
+}
+
+// ToSlice returns a snapshot of the elements from bottom to top
+func (s *SyncStack) ToSlice() []interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.ToSlice()
+}
@@ -0,0 +1,94 @@
+package ds
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSyncStack_Basic(t *testing.T) {
+	s := NewSyncStack()
+	s.Push(1)
+	s.Push(2)
+
+	if s.Peek() != 2 {
+		t.Errorf("expected peek 2, got %v", s.Peek())
+	}
+	if s.Pop() != 2 || s.Size() != 1 {
+		t.Error("expected pop 2 leaving one element")
+	}
+	s.Pop()
+	if !s.IsEmpty() || s.Pop() != nil {
+		t.Error("expected empty stack to pop nil")
+	}
+}
+
+func TestSyncStack_TryPopWithNil(t *testing.T) {
+	s := NewSyncStack()
+	s.Push(1)
+	s.Push(nil)
+	s.Push(2)
+
+	// Looping on Pop() != nil would stop at the nil and leave 1 behind
+	var drained []interface{}
+	for v, ok := s.TryPop(); ok; v, ok = s.TryPop() {
+		drained = append(drained, v)
+	}
+
+	expected := []interface{}{2, nil, 1}
+	if !reflect.DeepEqual(drained, expected) {
+		t.Errorf("expected %v, got %v", expected, drained)
+	}
+	if _, ok := s.TryPop(); ok || !s.IsEmpty() {
+		t.Error("expected TryPop on empty stack to return false")
+	}
+}
+
+// Run with: go test -race -run SyncStack ./internal/ds/
+func TestSyncStack_ConcurrentPushPop(t *testing.T) {
+	s := NewSyncStack()
+	workers, perWorker := 8, 500
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				s.Push(i)
+				s.Peek()
+				s.Size()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.Size() != workers*perWorker {
+		t.Fatalf("expected %d elements after concurrent pushes, got %d", workers*perWorker, s.Size())
+	}
+
+	// Concurrent pops: every element is popped exactly once
+	var mu sync.Mutex
+	popped := 0
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			count := 0
+			for _, ok := s.TryPop(); ok; _, ok = s.TryPop() {
+				count++
+			}
+			mu.Lock()
+			popped += count
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if popped != workers*perWorker {
+		t.Errorf("expected %d pops, got %d", workers*perWorker, popped)
+	}
+	if !s.IsEmpty() {
+		t.Errorf("expected empty stack, got size %d", s.Size())
+	}
+}
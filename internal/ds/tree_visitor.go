@@ -0,0 +1,59 @@
+package ds
+
+// Why interviewers ask this:
+// Sum, count, max depth, "collect all leaves" - most tree questions are the same walk with
+// a different bit of work at each node. Separating the traversal from the work (the
+// visitor pattern, or a fold) shows you can spot that repetition and write it once.
+
+// Common pitfalls:
+// - Duplicating the recursion for every new tree query
+// - Confusing pre-order (parent before children) with post-order (children before parent)
+// - Forgetting the nil root case, so every caller has to guard it
+// - Folds that depend on visit order without documenting which order is used
+
+// Key takeaway:
+// Walk owns the traversal and calls pre before a node's children and post after them.
+// Fold is a Walk that threads an accumulator through every value. Post-order is the
+// natural fit for bottom-up work (sizes, heights); pre-order for top-down (paths, printing).
+
+// Tree is a generic tree node with any number of children
+type Tree[T any] struct {
+	Value    T
+	Children []*Tree[T]
+}
+
+// NewTree creates a node with the given value and children
+func NewTree[T any](value T, children ...*Tree[T]) *Tree[T] {
+	return &Tree[T]{Value: value, Children: children}
+}
+
+// Walk visits every node depth-first, calling pre before a node's children and post
+// after them. Either callback may be nil.
+// Time Complexity: O(n)
+// Space Complexity: O(h) for the recursion stack, where h is the tree height
+func Walk[T any](root *Tree[T], pre, post func(node *Tree[T])) {
+	if root == nil {
+		return
+	}
+
+	if pre != nil {
+		pre(root)
+	}
+	for _, child := range root.Children {
+		Walk(child, pre, post)
+	}
+	if post != nil {
+		post(root)
+	}
+}
+
+// Fold combines every value into an accumulator, starting from init, in pre-order
+// Time Complexity: O(n)
+// Space Complexity: O(h) for the recursion stack, where h is the tree height
+func Fold[T, R any](root *Tree[T], init R, combine func(acc R, value T) R) R {
+	acc := init
+	Walk(root, func(node *Tree[T]) {
+		acc = combine(acc, node.Value)
+	}, nil)
+	return acc
+}
@@ -0,0 +1,88 @@
+package ds
+
+import (
+	"reflect"
+	"testing"
+)
+
+// sampleTree builds:
+//
+//	    1
+//	  / | \
+//	 2  3  4
+//	/ \    |
+//	5  6   7
+func sampleTree() *Tree[int] {
+	return NewTree(1,
+		NewTree(2, NewTree(5), NewTree(6)),
+		NewTree(3),
+		NewTree(4, NewTree(7)),
+	)
+}
+
+func TestFold_Sum(t *testing.T) {
+	sum := Fold(sampleTree(), 0, func(acc, v int) int { return acc + v })
+
+	if sum != 28 {
+		t.Errorf("expected 28, got %d", sum)
+	}
+}
+
+func TestFold_DifferentResultType(t *testing.T) {
+	root := NewTree("go", NewTree("is"), NewTree("fun"))
+
+	lengths := Fold(root, []int{}, func(acc []int, v string) []int {
+		return append(acc, len(v))
+	})
+
+	expected := []int{2, 2, 3}
+	if !reflect.DeepEqual(lengths, expected) {
+		t.Errorf("expected %v, got %v", expected, lengths)
+	}
+}
+
+func TestFold_NilRoot(t *testing.T) {
+	count := Fold[int](nil, 42, func(acc, v int) int { return acc + 1 })
+
+	if count != 42 {
+		t.Errorf("expected init value 42 for nil root, got %d", count)
+	}
+}
+
+func TestWalk_PreAndPostOrder(t *testing.T) {
+	var pre, post []int
+	Walk(sampleTree(),
+		func(n *Tree[int]) { pre = append(pre, n.Value) },
+		func(n *Tree[int]) { post = append(post, n.Value) },
+	)
+
+	expectedPre := []int{1, 2, 5, 6, 3, 4, 7}
+	expectedPost := []int{5, 6, 2, 3, 7, 4, 1}
+
+	if !reflect.DeepEqual(pre, expectedPre) {
+		t.Errorf("pre-order: expected %v, got %v", expectedPre, pre)
+	}
+	if !reflect.DeepEqual(post, expectedPost) {
+		t.Errorf("post-order: expected %v, got %v", expectedPost, post)
+	}
+}
+
+func TestWalk_PostOrderComputesHeights(t *testing.T) {
+	heights := make(map[*Tree[int]]int)
+	root := sampleTree()
+
+	// Children are always visited before their parent in post-order
+	Walk(root, nil, func(n *Tree[int]) {
+		h := 1
+		for _, child := range n.Children {
+			if heights[child]+1 > h {
+				h = heights[child] + 1
+			}
+		}
+		heights[n] = h
+	})
+
+	if heights[root] != 3 {
+		t.Errorf("expected height 3, got %d", heights[root])
+	}
+}
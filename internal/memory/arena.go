@@ -0,0 +1,95 @@
+package memory
+
+// Why interviewers ask this:
+// Game engines, network servers and parsers often know up front how many objects they
+// need at most. Allocating them all once and recycling slots by index keeps the GC out of
+// the hot path entirely - a useful contrast to sync.Pool, which the GC may empty at any time.
+
+// Common pitfalls:
+// - Handing out pointers into the backing slice and then growing it (pointers go stale)
+// - Double-freeing a slot so two owners later receive the same object
+// - Forgetting to reset a freed object, leaking old state into its next owner
+// - Using an arena for objects whose lifetime isn't clearly bounded (use-after-free by index)
+
+// Key takeaway:
+// Allocate one []T up front and keep a stack of free indices: Alloc pops, Free pushes,
+// both O(1) with zero allocations. Indices stay valid because the slice never grows.
+// Track which slots are in use to reject double frees, and zero slots on Free.
+
+// Arena is a fixed-capacity pool of T values addressed by index
+type Arena[T any] struct {
+	objects []T
+	free    []int  // Stack of free slot indices
+	inUse   []bool // Guards against double free and access to free slots
+}
+
+// NewArena pre-allocates capacity objects
+func NewArena[T any](capacity int) *Arena[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+
+	a := &Arena[T]{
+		objects: make([]T, capacity),
+		free:    make([]int, capacity),
+		inUse:   make([]bool, capacity),
+	}
+
+	// Push in reverse so slots are handed out 0, 1, 2, ...
+	for i := range a.free {
+		a.free[i] = capacity - 1 - i
+	}
+
+	return a
+}
+
+// Alloc reserves a free slot and returns its index
+// Returns -1 and false if the arena is full
+// Time Complexity: O(1), no heap allocation
+func (a *Arena[T]) Alloc() (int, bool) {
+	if len(a.free) == 0 {
+		return -1, false
+	}
+
+	index := a.free[len(a.free)-1]
+	a.free = a.free[:len(a.free)-1]
+	a.inUse[index] = true
+
+	return index, true
+}
+
+// Free zeroes the slot at index and makes it available again
+// Returns false if index is out of range or not currently allocated
+// Time Complexity: O(1)
+func (a *Arena[T]) Free(index int) bool {
+	if index < 0 || index >= len(a.objects) || !a.inUse[index] {
+		return false
+	}
+
+	var zero T
+	a.objects[index] = zero
+	a.inUse[index] = false
+	a.free = append(a.free, index) // Never exceeds capacity, so never reallocates
+
+	return true
+}
+
+// Get returns a pointer to the object in an allocated slot
+// Returns nil if index is out of range or not currently allocated
+// The pointer stays valid for the arena's lifetime, since the backing slice never grows
+func (a *Arena[T]) Get(index int) *T {
+	if index < 0 || index >= len(a.objects) || !a.inUse[index] {
+		return nil
+	}
+	return &a.objects[index]
+}
+
+// Len returns the number of allocated slots
+func (a *Arena[T]) Len() int {
+	return len(a.objects) - len(a.free)
+}
+
+// Cap returns the total number of slots
+func (a *Arena[T]) Cap() int {
+	return len(a.objects)
+}
@@ -0,0 +1,110 @@
+package memory
+
+import "testing"
+
+type particle struct {
+	X, Y, VX, VY float64
+}
+
+func TestArena_AllocDistinctSlotsUntilFull(t *testing.T) {
+	arena := NewArena[particle](4)
+
+	seen := make(map[int]bool)
+	for i := 0; i < 4; i++ {
+		index, ok := arena.Alloc()
+		if !ok {
+			t.Fatalf("alloc %d should succeed", i)
+		}
+		if seen[index] {
+			t.Fatalf("slot %d handed out twice", index)
+		}
+		seen[index] = true
+	}
+
+	if index, ok := arena.Alloc(); ok || index != -1 {
+		t.Errorf("expected alloc on full arena to fail, got %d", index)
+	}
+	if arena.Len() != 4 || arena.Cap() != 4 {
+		t.Errorf("expected len 4 cap 4, got len %d cap %d", arena.Len(), arena.Cap())
+	}
+}
+
+func TestArena_FreeMakesSlotReusable(t *testing.T) {
+	arena := NewArena[particle](2)
+
+	first, _ := arena.Alloc()
+	arena.Alloc()
+
+	arena.Get(first).X = 42
+	if !arena.Free(first) {
+		t.Fatal("free of allocated slot should succeed")
+	}
+
+	reused, ok := arena.Alloc()
+	if !ok || reused != first {
+		t.Fatalf("expected freed slot %d to be reused, got %d (ok=%v)", first, reused, ok)
+	}
+	if arena.Get(reused).X != 0 {
+		t.Errorf("expected reused slot to be zeroed, got X=%v", arena.Get(reused).X)
+	}
+}
+
+func TestArena_RejectsInvalidAndDoubleFree(t *testing.T) {
+	arena := NewArena[int](2)
+	index, _ := arena.Alloc()
+
+	if !arena.Free(index) {
+		t.Fatal("first free should succeed")
+	}
+	if arena.Free(index) {
+		t.Error("double free should fail")
+	}
+	if arena.Free(-1) || arena.Free(2) {
+		t.Error("free of out-of-range index should fail")
+	}
+	if arena.Get(index) != nil {
+		t.Error("Get on a free slot should return nil")
+	}
+	if arena.Len() != 0 {
+		t.Errorf("expected len 0, got %d", arena.Len())
+	}
+}
+
+func TestArena_ZeroAllocations(t *testing.T) {
+	arena := NewArena[particle](64)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		index, _ := arena.Alloc()
+		arena.Get(index).VX = 1
+		arena.Free(index)
+	})
+
+	if allocs != 0 {
+		t.Errorf("expected arena alloc/free to allocate nothing, got %v", allocs)
+	}
+}
+
+// Benchmarks
+// Run with: go test -bench=Particle -benchmem ./internal/memory/
+
+var particleSink *particle
+
+func BenchmarkParticleHeap(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		particleSink = &particle{VX: 1}
+	}
+}
+
+func BenchmarkParticleArena(b *testing.B) {
+	arena := NewArena[particle](1)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index, _ := arena.Alloc()
+		particleSink = arena.Get(index)
+		particleSink.VX = 1
+		arena.Free(index)
+	}
+}
@@ -0,0 +1,91 @@
+package memory
+
+// Why interviewers ask this:
+// A [][]int looks like a 2D array but is really a slice of independent row slices, each
+// its own heap allocation. Interviewers use grids to probe whether you understand data
+// layout, cache locality, and allocation counts - the difference between "it works" and
+// "it's fast" in matrix, image, and game-board code.
+
+// Common pitfalls:
+// - Assuming [][]int is contiguous in memory (each row can live anywhere)
+// - Allocating rows+1 times when one allocation would do
+// - Getting the index math wrong: row-major is row*cols + col, not col*rows + row
+// - Iterating column-first over a row-major layout and defeating the CPU cache
+
+// Key takeaway:
+// A flat []int of size rows*cols with index row*cols+col is a single allocation and
+// sequential in memory, so row-major iteration streams through cache lines. The jagged
+// form costs rows+1 allocations and a pointer chase per row.
+
+// NewJaggedGrid allocates a rows x cols grid as a slice of row slices
+// Allocations: one per row plus the outer slice (rows + 1)
+func NewJaggedGrid(rows, cols int) [][]int {
+	grid := make([][]int, rows)
+	for r := range grid {
+		grid[r] = make([]int, cols)
+	}
+	return grid
+}
+
+// FlatGrid is a rows x cols grid backed by a single contiguous slice in row-major order
+type FlatGrid struct {
+	rows int
+	cols int
+	data []int
+}
+
+// NewFlatGrid allocates a rows x cols grid with a single backing slice
+// Allocations: one backing slice (plus the struct if it escapes), regardless of size
+func NewFlatGrid(rows, cols int) *FlatGrid {
+	return &FlatGrid{
+		rows: rows,
+		cols: cols,
+		data: make([]int, rows*cols),
+	}
+}
+
+// index converts (row, col) into a position in the backing slice
+func (g *FlatGrid) index(row, col int) int {
+	return row*g.cols + col
+}
+
+// Get returns the value at (row, col)
+func (g *FlatGrid) Get(row, col int) int {
+	return g.data[g.index(row, col)]
+}
+
+// Set stores value at (row, col)
+func (g *FlatGrid) Set(row, col, value int) {
+	g.data[g.index(row, col)] = value
+}
+
+// Rows returns the number of rows
+func (g *FlatGrid) Rows() int {
+	return g.rows
+}
+
+// Cols returns the number of columns
+func (g *FlatGrid) Cols() int {
+	return g.cols
+}
+
+// SumJaggedGrid sums every cell in row-major order
+func SumJaggedGrid(grid [][]int) int {
+	sum := 0
+	for _, row := range grid {
+		for _, v := range row {
+			sum += v
+		}
+	}
+	return sum
+}
+
+// SumFlatGrid sums every cell; iterating the backing slice directly is the
+// same as row-major order and touches memory strictly sequentially
+func SumFlatGrid(g *FlatGrid) int {
+	sum := 0
+	for _, v := range g.data {
+		sum += v
+	}
+	return sum
+}
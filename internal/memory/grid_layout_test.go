@@ -0,0 +1,105 @@
+package memory
+
+import "testing"
+
+func TestGridLayout_SameBehavior(t *testing.T) {
+	rows, cols := 3, 4
+	jagged := NewJaggedGrid(rows, cols)
+	flat := NewFlatGrid(rows, cols)
+
+	ops := []struct {
+		row, col, value int
+	}{
+		{0, 0, 1},
+		{0, 3, 2},
+		{2, 0, 3},
+		{1, 2, 4},
+		{2, 3, 5},
+		{0, 0, 6}, // Overwrite
+	}
+
+	for _, op := range ops {
+		jagged[op.row][op.col] = op.value
+		flat.Set(op.row, op.col, op.value)
+	}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if jagged[r][c] != flat.Get(r, c) {
+				t.Errorf("mismatch at (%d,%d): jagged=%d flat=%d", r, c, jagged[r][c], flat.Get(r, c))
+			}
+		}
+	}
+
+	if SumJaggedGrid(jagged) != SumFlatGrid(flat) {
+		t.Errorf("sums differ: %d vs %d", SumJaggedGrid(jagged), SumFlatGrid(flat))
+	}
+}
+
+func TestFlatGrid_Dimensions(t *testing.T) {
+	g := NewFlatGrid(2, 5)
+
+	if g.Rows() != 2 || g.Cols() != 5 {
+		t.Errorf("expected 2x5, got %dx%d", g.Rows(), g.Cols())
+	}
+}
+
+func TestFlatGrid_RowMajorIndex(t *testing.T) {
+	g := NewFlatGrid(2, 3)
+	g.Set(1, 0, 42)
+
+	// (1, 0) in a 3-column grid lives at index 1*3 + 0 = 3
+	if g.data[3] != 42 {
+		t.Errorf("expected data[3] = 42, got %v", g.data)
+	}
+}
+
+func TestGridLayout_Allocations(t *testing.T) {
+	rows, cols := 64, 64
+
+	jaggedAllocs := testing.AllocsPerRun(10, func() {
+		_ = NewJaggedGrid(rows, cols)
+	})
+	flatAllocs := testing.AllocsPerRun(10, func() {
+		_ = NewFlatGrid(rows, cols)
+	})
+
+	if flatAllocs >= jaggedAllocs {
+		t.Errorf("expected flat grid to allocate less: flat=%v jagged=%v", flatAllocs, jaggedAllocs)
+	}
+}
+
+// Benchmarks
+// Run with: go test -bench=Grid -benchmem ./internal/memory/
+
+func BenchmarkJaggedGridAlloc(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = NewJaggedGrid(256, 256)
+	}
+}
+
+func BenchmarkFlatGridAlloc(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = NewFlatGrid(256, 256)
+	}
+}
+
+func BenchmarkJaggedGridIterate(b *testing.B) {
+	grid := NewJaggedGrid(256, 256)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result = SumJaggedGrid(grid)
+	}
+}
+
+func BenchmarkFlatGridIterate(b *testing.B) {
+	grid := NewFlatGrid(256, 256)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result = SumFlatGrid(grid)
+	}
+}
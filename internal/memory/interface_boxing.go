@@ -0,0 +1,47 @@
+package memory
+
+// Why interviewers ask this:
+// interface{} (any) looks free, but an interface value is a (type, data) pair where data
+// must be a pointer. Storing a non-pointer value usually means copying it to the heap
+// ("boxing"). Interviewers ask this to see if you can explain why []interface{} code is
+// slower and allocation-heavy compared to concrete types or generics.
+
+// Common pitfalls:
+// - Using []interface{} / map[string]interface{} on hot paths "for flexibility"
+// - Assuming small ints never allocate (only 0-255 hit the runtime's static cache)
+// - Benchmarking without -benchmem and missing the allocation cost entirely
+// - Reaching for interface{} where a generic function would keep concrete types
+
+// Key takeaway:
+// Converting an int to interface{} allocates when the value escapes (for example, when
+// stored in a slice). Keeping values concrete, or using generics, avoids the allocation
+// and the extra pointer indirection when reading them back.
+
+// SumViaInt sums values using concrete ints: no boxing, no allocations
+func SumViaInt(values []int) int {
+	sum := 0
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+// SumViaInterface stores every value in an []interface{} before summing,
+// forcing each int to be boxed onto the heap
+func SumViaInterface(values []int) int {
+	boxed := make([]interface{}, len(values))
+	for i, v := range values {
+		boxed[i] = v // Boxing: allocates for values outside 0-255
+	}
+
+	return sumInterfaces(boxed)
+}
+
+// sumInterfaces unboxes each element with a type assertion
+func sumInterfaces(values []interface{}) int {
+	sum := 0
+	for _, v := range values {
+		sum += v.(int)
+	}
+	return sum
+}
@@ -0,0 +1,63 @@
+package memory
+
+import "testing"
+
+// boxingValues uses values above 255 so the runtime's small-int cache can't avoid allocation
+func boxingValues(n int) []int {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = 1000 + i
+	}
+	return values
+}
+
+func TestInterfaceBoxing_SameSum(t *testing.T) {
+	values := boxingValues(100)
+
+	if SumViaInt(values) != SumViaInterface(values) {
+		t.Errorf("sums differ: %d vs %d", SumViaInt(values), SumViaInterface(values))
+	}
+}
+
+func TestInterfaceBoxing_Allocations(t *testing.T) {
+	values := boxingValues(100)
+
+	intAllocs := testing.AllocsPerRun(10, func() {
+		result = SumViaInt(values)
+	})
+	ifaceAllocs := testing.AllocsPerRun(10, func() {
+		result = SumViaInterface(values)
+	})
+
+	if intAllocs != 0 {
+		t.Errorf("expected concrete int sum to allocate nothing, got %v", intAllocs)
+	}
+
+	// One allocation per boxed value (plus the slice itself)
+	if ifaceAllocs < float64(len(values)) {
+		t.Errorf("expected at least %d allocations for boxing, got %v", len(values), ifaceAllocs)
+	}
+}
+
+// Benchmarks
+// Run with: go test -bench=Via -benchmem ./internal/memory/
+
+func BenchmarkSumViaInt(b *testing.B) {
+	values := boxingValues(1000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result = SumViaInt(values)
+	}
+}
+
+func BenchmarkSumViaInterface(b *testing.B) {
+	values := boxingValues(1000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result = SumViaInterface(values)
+	}
+}